@@ -22,6 +22,7 @@ import (
 var (
 	FifteenMinutes               = 15 * time.Minute
 	TwoHours                     = 120 * time.Minute
+	FourHours                    = 240 * time.Minute
 	ZeroTime       time.Duration = 0
 
 	DefaultTimeout = &schema.ResourceTimeout{
@@ -33,8 +34,47 @@ var (
 
 const (
 	FAILED = "FAILED"
+
+	fastForwardWait = time.Millisecond
 )
 
+// FastForwarder is implemented by the HTTP dispatchers of the clients that shorten the waits between the refreshes and
+// retries of their resources, and after creating and deleting them, to a millisecond. The clients of the acceptance
+// tests do while they replay recorded responses, which don't need time to change.
+type FastForwarder interface {
+	FastForward() bool
+}
+
+// WaitDuration returns the duration to wait before refreshing a resource, or retrying a request, with the given HTTP
+// dispatcher or retry settings.
+func WaitDuration(dispatcher interface{}, duration time.Duration) time.Duration {
+	if fastForwards(dispatcher) && duration > fastForwardWait {
+		return fastForwardWait
+	}
+	return duration
+}
+
+func fastForwards(dispatcher interface{}) bool {
+	fastForwarder, ok := dispatcher.(FastForwarder)
+	return ok && fastForwarder.FastForward()
+}
+
+// resourceDispatcher returns the HTTP dispatcher of the client of a resource, or nil if it has none.
+func resourceDispatcher(sync interface{}) interface{} {
+	v := reflect.ValueOf(sync)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	client := v.Elem().FieldByName("Client")
+	if !client.IsValid() || client.Kind() != reflect.Ptr || client.IsNil() || client.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	if dispatcher := client.Elem().FieldByName("HTTPClient"); dispatcher.IsValid() && dispatcher.CanInterface() {
+		return dispatcher.Interface()
+	}
+	return nil
+}
+
 type BaseCrud struct {
 	D     *schema.ResourceData
 	Mutex *sync.Mutex
@@ -189,6 +229,9 @@ func LoadBalancerWaitForWorkRequest(client *oci_load_balancer.LoadBalancerClient
 		},
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
+	if fastForwards(client.HTTPClient) {
+		stateConf.PollInterval = fastForwardWait
+	}
 
 	if _, e = stateConf.WaitForState(); e != nil {
 		return e
@@ -208,10 +251,16 @@ func CreateDBSystemResource(d *schema.ResourceData, sync ResourceCreator) (e err
 	d.SetId(sync.ID())
 
 	var timeout time.Duration
-	shape := d.Get("shape")
 	timeout = d.Timeout(schema.TimeoutCreate)
 	if timeout == 0 {
-		if strings.HasPrefix(shape.(string), "Exadata") {
+		var shape string
+		if reporter, ok := sync.(DBSystemShapeReporter); ok {
+			shape = reporter.DBSystemShape()
+		} else {
+			shape = d.Get("shape").(string)
+		}
+
+		if strings.HasPrefix(shape, "Exadata") {
 			timeout = time.Duration(12) * time.Hour
 		} else {
 			timeout = time.Duration(2) * time.Hour
@@ -225,7 +274,7 @@ func CreateDBSystemResource(d *schema.ResourceData, sync ResourceCreator) (e err
 	sync.SetData()
 
 	if ew, waitOK := sync.(ExtraWaitPostCreateDelete); waitOK {
-		time.Sleep(ew.ExtraWaitPostCreateDelete())
+		time.Sleep(WaitDuration(resourceDispatcher(sync), ew.ExtraWaitPostCreateDelete()))
 	}
 
 	return
@@ -260,7 +309,7 @@ func CreateResource(d *schema.ResourceData, sync ResourceCreator) (e error) {
 	sync.SetData()
 
 	if ew, waitOK := sync.(ExtraWaitPostCreateDelete); waitOK {
-		time.Sleep(ew.ExtraWaitPostCreateDelete())
+		time.Sleep(WaitDuration(resourceDispatcher(sync), ew.ExtraWaitPostCreateDelete()))
 	}
 
 	return
@@ -334,7 +383,7 @@ func DeleteResource(d *schema.ResourceData, sync ResourceDeleter) (e error) {
 	}
 
 	if ew, waitOK := sync.(ExtraWaitPostCreateDelete); waitOK {
-		time.Sleep(ew.ExtraWaitPostCreateDelete())
+		time.Sleep(WaitDuration(resourceDispatcher(sync), ew.ExtraWaitPostCreateDelete()))
 	}
 
 	if e == nil {
@@ -372,6 +421,9 @@ func waitForStateRefresh(sync StatefulResource, timeout time.Duration, operation
 		Refresh: stateRefreshFunc(sync),
 		Timeout: timeout,
 	}
+	if fastForwards(resourceDispatcher(sync)) {
+		stateConf.PollInterval = fastForwardWait
+	}
 
 	if _, e = stateConf.WaitForState(); e != nil {
 		handleMissingResourceError(sync, &e)
@@ -480,8 +532,32 @@ func WaitForResourceCondition(s ResourceFetcher, resourceChangedFunc func() bool
 			lastAttempt = true
 		}
 
-		time.Sleep(backoffTime)
+		time.Sleep(WaitDuration(resourceDispatcher(s), backoffTime))
 	}
 
 	return nil
 }
+
+// WaitForActionStarted polls on a resource at a fixed interval, waiting for an action on it to start. It is meant for
+// actions that return the resource to the state it was in before the action, such as an instance reset, where the
+// transition can complete between two polls of WaitForResourceCondition and never be observed. Once settleWindow has
+// passed without the action being seen to start, it is assumed to have completed, and this returns without an error.
+func WaitForActionStarted(s ResourceFetcher, actionStartedFunc func() bool, pollInterval time.Duration, settleWindow time.Duration) error {
+	// Count the polls rather than the elapsed time, so that fast-forwarded polls also end the window
+	for elapsed := time.Duration(0); ; elapsed += pollInterval {
+		if err := s.Get(); err != nil {
+			return err
+		}
+
+		if actionStartedFunc() {
+			return nil
+		}
+
+		if elapsed >= settleWindow {
+			log.Printf("[DEBUG] Action wasn't seen to start within %v, assuming it has completed", settleWindow)
+			return nil
+		}
+
+		time.Sleep(WaitDuration(resourceDispatcher(s), pollInterval))
+	}
+}
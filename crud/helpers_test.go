@@ -5,6 +5,8 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
 )
 
 type TestResource struct {
@@ -82,3 +84,88 @@ func TestWaitForResourceCondition_basic(t *testing.T) {
 		return
 	}
 }
+
+func TestWaitForActionStarted_basic(t *testing.T) {
+	// The action is seen to start on the third poll
+	testResource := &TestResource{GetError: nil, GetAttempts: 3}
+	startedFunc := func() bool {
+		return testResource.GetAttempts == 0
+	}
+	if err := WaitForActionStarted(testResource, startedFunc, time.Millisecond, time.Minute); err != nil {
+		t.Errorf("Got unexpected error '%q'", err)
+	}
+	if testResource.ActualGetAttempts != 3 {
+		t.Errorf("Expected 3 Get attempts, got %d instead", testResource.ActualGetAttempts)
+	}
+
+	// The action completes between two polls, so it's never seen to start
+	testResource = &TestResource{GetError: nil, GetAttempts: 100}
+	if err := WaitForActionStarted(testResource, startedFunc, time.Millisecond, 4*time.Millisecond); err != nil {
+		t.Errorf("Got unexpected error '%q', expected the action to be assumed complete", err)
+	}
+	if testResource.ActualGetAttempts != 5 {
+		t.Errorf("Expected 5 Get attempts, got %d instead", testResource.ActualGetAttempts)
+	}
+
+	// Get returns an error
+	testResource = &TestResource{GetError: fmt.Errorf("GetError"), GetAttempts: 2}
+	if err := WaitForActionStarted(testResource, startedFunc, time.Millisecond, time.Minute); err == nil || !strings.HasPrefix(err.Error(), "GetError") {
+		t.Errorf("Got unexpected error '%q', expected a GetError", err)
+	}
+}
+
+type testFastForwardingDispatcher struct {
+	oci_common.HTTPRequestDispatcher
+}
+
+func (d testFastForwardingDispatcher) FastForward() bool {
+	return true
+}
+
+type testStatefulResource struct {
+	BaseCrud
+	Client *oci_common.BaseClient
+	states []string
+	state  string
+}
+
+func (r *testStatefulResource) Get() error {
+	r.state, r.states = r.states[0], r.states[1:]
+	return nil
+}
+
+func (r *testStatefulResource) State() string {
+	return r.state
+}
+
+func (r *testStatefulResource) setState(StatefulResource) error {
+	return nil
+}
+
+func (r *testStatefulResource) SetData() {}
+
+func TestWaitForStateRefresh_fastForward(t *testing.T) {
+	// Without fast-forwarding, the refreshes would be 0.1s, 0.2s, 0.4s, 0.8s and 1.6s apart
+	client := &oci_common.BaseClient{HTTPClient: testFastForwardingDispatcher{}}
+	resource := &testStatefulResource{Client: client, states: []string{"PROVISIONING", "PROVISIONING", "PROVISIONING", "PROVISIONING", "PROVISIONING", "AVAILABLE"}}
+	start := time.Now()
+	if err := waitForStateRefresh(resource, time.Minute, "creation", []string{"PROVISIONING"}, []string{"AVAILABLE"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the refreshes to be fast-forwarded, took %v", elapsed)
+	}
+	if len(resource.states) != 0 {
+		t.Errorf("Expected every state to be refreshed, %d left", len(resource.states))
+	}
+
+	if duration := WaitDuration(resourceDispatcher(resource), time.Minute); duration != fastForwardWait {
+		t.Errorf("Expected the wait to be fast-forwarded, got %v", duration)
+	}
+	if duration := WaitDuration(resourceDispatcher(&testStatefulResource{Client: &oci_common.BaseClient{}}), time.Minute); duration != time.Minute {
+		t.Errorf("Expected the wait not to be fast-forwarded without a fast-forwarding client, got %v", duration)
+	}
+	if duration := WaitDuration(resourceDispatcher(&testStatefulResource{}), time.Minute); duration != time.Minute {
+		t.Errorf("Expected the wait not to be fast-forwarded without a client, got %v", duration)
+	}
+}
@@ -103,3 +103,9 @@ type StatefullyDeletedResource interface {
 type SynchronizedResource interface {
 	GetMutex() *sync.Mutex
 }
+
+// Resources that are provisioned onto a DB system, without the DB system shape being part of their own
+// configuration, can report the shape so CreateDBSystemResource can pick a suitable default timeout.
+type DBSystemShapeReporter interface {
+	DBSystemShape() string
+}
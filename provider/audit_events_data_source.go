@@ -0,0 +1,335 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_audit "github.com/oracle/oci-go-sdk/audit"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+)
+
+func AuditEventsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readAuditEvents,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAuditEventsTime,
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAuditEventsTime,
+			},
+			"audit_events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"compartment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"credential_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_agent": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_headers": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     schema.TypeString,
+						},
+						"request_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_origin": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_parameters": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     schema.TypeString,
+						},
+						"request_resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"response_headers": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     schema.TypeString,
+						},
+						"response_payload": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"response_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"response_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readAuditEvents(d *schema.ResourceData, m interface{}) error {
+	sync := &AuditEventsDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).auditClient
+
+	return crud.ReadResource(sync)
+}
+
+type AuditEventsDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_audit.AuditClient
+	Res    *oci_audit.ListEventsResponse
+}
+
+func (s *AuditEventsDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *AuditEventsDataSourceCrud) Get() error {
+	request := oci_audit.ListEventsRequest{}
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	now := time.Now()
+
+	startTime, err := parseAuditEventsTime(s.D.Get("start_time").(string), now)
+	if err != nil {
+		return err
+	}
+	request.StartTime = &oci_common.SDKTime{Time: startTime}
+
+	endTime := now
+	if rawEndTime, ok := s.D.GetOkExists("end_time"); ok && rawEndTime.(string) != "" {
+		if endTime, err = parseAuditEventsTime(rawEndTime.(string), now); err != nil {
+			return err
+		}
+	}
+	request.EndTime = &oci_common.SDKTime{Time: endTime}
+
+	if !startTime.Before(endTime) {
+		return fmt.Errorf("start_time '%s' must be before end_time '%s'", startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "audit")
+
+	response, err := s.Client.ListEvents(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	request.Page = s.Res.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListEvents(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return nil
+}
+
+func (s *AuditEventsDataSourceCrud) SetData() {
+	if s.Res == nil {
+		return
+	}
+
+	s.D.SetId(crud.GenerateDataSourceID())
+	resources := []map[string]interface{}{}
+
+	for _, r := range s.Res.Items {
+		auditEvent := map[string]interface{}{}
+
+		if r.CompartmentId != nil {
+			auditEvent["compartment_id"] = *r.CompartmentId
+		}
+
+		if r.CredentialId != nil {
+			auditEvent["credential_id"] = *r.CredentialId
+		}
+
+		if r.EventId != nil {
+			auditEvent["event_id"] = *r.EventId
+		}
+
+		if r.EventName != nil {
+			auditEvent["event_name"] = *r.EventName
+		}
+
+		if r.EventSource != nil {
+			auditEvent["event_source"] = *r.EventSource
+		}
+
+		if r.EventTime != nil {
+			auditEvent["event_time"] = r.EventTime.String()
+		}
+
+		if r.EventType != nil {
+			auditEvent["event_type"] = *r.EventType
+		}
+
+		if r.PrincipalId != nil {
+			auditEvent["principal_id"] = *r.PrincipalId
+		}
+
+		if r.RequestAction != nil {
+			auditEvent["request_action"] = *r.RequestAction
+		}
+
+		if r.RequestAgent != nil {
+			auditEvent["request_agent"] = *r.RequestAgent
+		}
+
+		auditEvent["request_headers"] = multiValueMapToStringMap(r.RequestHeaders)
+
+		if r.RequestId != nil {
+			auditEvent["request_id"] = *r.RequestId
+		}
+
+		if r.RequestOrigin != nil {
+			auditEvent["request_origin"] = *r.RequestOrigin
+		}
+
+		auditEvent["request_parameters"] = multiValueMapToStringMap(r.RequestParameters)
+
+		if r.RequestResource != nil {
+			auditEvent["request_resource"] = *r.RequestResource
+		}
+
+		auditEvent["response_headers"] = multiValueMapToStringMap(r.ResponseHeaders)
+
+		if r.ResponsePayload != nil {
+			if payload, err := json.Marshal(r.ResponsePayload); err == nil {
+				auditEvent["response_payload"] = string(payload)
+			}
+		}
+
+		if r.ResponseStatus != nil {
+			auditEvent["response_status"] = *r.ResponseStatus
+		}
+
+		if r.ResponseTime != nil {
+			auditEvent["response_time"] = r.ResponseTime.String()
+		}
+
+		if r.TenantId != nil {
+			auditEvent["tenant_id"] = *r.TenantId
+		}
+
+		resources = append(resources, auditEvent)
+	}
+
+	if f, fOk := s.D.GetOkExists("filter"); fOk {
+		resources = ApplyFilters(f.(*schema.Set), resources, AuditEventsDataSource().Schema["audit_events"].Elem.(*schema.Resource).Schema)
+	}
+
+	if err := s.D.Set("audit_events", resources); err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// parseAuditEventsTime accepts either an RFC3339 timestamp or a duration relative to now, such as "-24h".
+func parseAuditEventsTime(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	if duration, err := time.ParseDuration(value); err == nil {
+		return now.Add(duration), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time '%s', must be an RFC3339 timestamp such as '2018-07-01T10:00:00Z' or a duration relative to the current time such as '-24h'", value)
+}
+
+func validateAuditEventsTime(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseAuditEventsTime(v.(string), time.Now()); err != nil {
+		errors = append(errors, fmt.Errorf("%s: %s", k, err))
+	}
+	return
+}
+
+// Headers and parameters can have several values, which are joined with commas as in HTTP headers.
+func multiValueMapToStringMap(m map[string][]string) map[string]string {
+	result := map[string]string{}
+	for key, values := range m {
+		result[key] = strings.Join(values, ",")
+	}
+	return result
+}
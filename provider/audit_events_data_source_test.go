@@ -0,0 +1,96 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAuditAuditEventsDataSource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	datasourceName := "data.oci_audit_events.test_audit_events"
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify datasource with a relative time window
+			{
+				Config: config + `
+data "oci_audit_events" "test_audit_events" {
+	#Required
+	compartment_id = "${var.compartment_id}"
+	start_time = "-24h"
+
+	#Optional
+	end_time = "-1m"
+}
+                ` + compartmentIdVariableStr,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttr(datasourceName, "end_time", "-1m"),
+					resource.TestCheckResourceAttr(datasourceName, "start_time", "-24h"),
+					resource.TestCheckResourceAttrSet(datasourceName, "audit_events.#"),
+				),
+			},
+			// verify datasource with an absolute time window and a filter
+			{
+				Config: config + fmt.Sprintf(`
+data "oci_audit_events" "test_audit_events" {
+	#Required
+	compartment_id = "${var.compartment_id}"
+	start_time = "%s"
+
+	filter {
+		name = "compartment_id"
+		values = ["${var.compartment_id}"]
+	}
+}
+                `, time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)) + compartmentIdVariableStr,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttrSet(datasourceName, "audit_events.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitParseAuditEventsTime(t *testing.T) {
+	now := time.Date(2018, 7, 25, 12, 0, 0, 0, time.UTC)
+
+	for value, expected := range map[string]time.Time{
+		"2018-07-01T10:00:00Z":      time.Date(2018, 7, 1, 10, 0, 0, 0, time.UTC),
+		"2018-07-01T10:00:00+02:00": time.Date(2018, 7, 1, 8, 0, 0, 0, time.UTC),
+		"-24h":                      time.Date(2018, 7, 24, 12, 0, 0, 0, time.UTC),
+		"-90m":                      time.Date(2018, 7, 25, 10, 30, 0, 0, time.UTC),
+		"0s":                        now,
+	} {
+		actual, err := parseAuditEventsTime(value, now)
+		if err != nil {
+			t.Errorf("Unexpected error parsing '%s': %v", value, err)
+			continue
+		}
+
+		if !actual.Equal(expected) {
+			t.Errorf("Expected '%s' to be parsed as %s, got %s", value, expected, actual)
+		}
+	}
+
+	for _, value := range []string{"", "yesterday", "2018-07-01", "-1d"} {
+		if _, err := parseAuditEventsTime(value, now); err == nil {
+			t.Errorf("Expected an error parsing '%s'", value)
+		}
+	}
+}
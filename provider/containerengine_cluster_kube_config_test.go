@@ -31,7 +31,7 @@ func TestContainerengineClusterKubeConfigResource_basic(t *testing.T) {
 
 	singularDatasourceName := "data.oci_containerengine_cluster_kube_config.test_cluster_kube_config"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
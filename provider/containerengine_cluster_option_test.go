@@ -27,7 +27,7 @@ func TestContainerengineClusterOptionResource_basic(t *testing.T) {
 
 	singularDatasourceName := "data.oci_containerengine_cluster_option.test_cluster_option"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
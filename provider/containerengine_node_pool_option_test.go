@@ -27,7 +27,7 @@ func TestContainerengineNodePoolOptionResource_basic(t *testing.T) {
 
 	singularDatasourceName := "data.oci_containerengine_node_pool_option.test_node_pool_option"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -38,7 +38,7 @@ func TestContainerengineWorkRequestErrorResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_containerengine_work_request_errors.test_work_request_errors"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
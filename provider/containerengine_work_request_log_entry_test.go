@@ -39,7 +39,7 @@ func TestContainerengineWorkRequestLogEntryResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_containerengine_work_request_log_entries.test_work_request_log_entries"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
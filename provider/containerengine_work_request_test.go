@@ -31,7 +31,7 @@ func TestContainerengineWorkRequestResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_containerengine_work_requests.test_work_requests"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -3,22 +3,97 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
 const (
 	BootVolumeAttachmentResourceConfig = BootVolumeAttachmentResourceDependencies + `
 
+`
+
+	BootVolumeAttachmentSwapResourceConfig = BootVolumePropertyVariables + BootVolumeCloneResourceConfig + `
+resource "oci_core_boot_volume_attachment" "test_boot_volume_attachment" {
+	#Required
+	boot_volume_id = "${oci_core_boot_volume.test_boot_volume.id}"
+	instance_id = "${oci_core_instance.test_instance.id}"
+
+	#Optional
+	display_name = "swappedBootVolume"
+}
 `
 
 	BootVolumeAttachmentResourceDependencies = BootVolumeResourceDependencies
 )
 
+// stopInstanceAndDetachBootVolume stops the given instance and detaches its current boot volume, so that a
+// different boot volume can be attached to it.
+func stopInstanceAndDetachBootVolume(t *testing.T, availabilityDomain string, compartmentId string, instanceId string) {
+	client := GetTestProvider().computeClient
+
+	_, err := client.InstanceAction(context.Background(), oci_core.InstanceActionRequest{
+		InstanceId: &instanceId,
+		Action:     oci_core.InstanceActionActionStop,
+	})
+	if err != nil {
+		t.Fatalf("Unable to stop instance. Error: %q", err)
+	}
+
+	waitForTestCondition(t, "instance to stop", func() (bool, error) {
+		response, err := client.GetInstance(context.Background(), oci_core.GetInstanceRequest{InstanceId: &instanceId})
+		return response.LifecycleState == oci_core.InstanceLifecycleStateStopped, err
+	})
+
+	listResponse, err := client.ListBootVolumeAttachments(context.Background(), oci_core.ListBootVolumeAttachmentsRequest{
+		AvailabilityDomain: &availabilityDomain,
+		CompartmentId:      &compartmentId,
+		InstanceId:         &instanceId,
+	})
+	if err != nil {
+		t.Fatalf("Unable to list boot volume attachments. Error: %q", err)
+	}
+
+	for _, attachment := range listResponse.Items {
+		if attachment.LifecycleState != oci_core.BootVolumeAttachmentLifecycleStateAttached {
+			continue
+		}
+
+		_, err := client.DetachBootVolume(context.Background(), oci_core.DetachBootVolumeRequest{BootVolumeAttachmentId: attachment.Id})
+		if err != nil {
+			t.Fatalf("Unable to detach boot volume. Error: %q", err)
+		}
+
+		waitForTestCondition(t, "boot volume to detach", func() (bool, error) {
+			response, err := client.GetBootVolumeAttachment(context.Background(), oci_core.GetBootVolumeAttachmentRequest{BootVolumeAttachmentId: attachment.Id})
+			return response.LifecycleState == oci_core.BootVolumeAttachmentLifecycleStateDetached, err
+		})
+	}
+}
+
+func waitForTestCondition(t *testing.T, description string, condition func() (bool, error)) {
+	timeout := time.Now().Add(15 * time.Minute)
+	for {
+		done, err := condition()
+		if err != nil {
+			t.Fatalf("Error while waiting for %s. Error: %q", description, err)
+		}
+		if done {
+			return
+		}
+		if time.Now().After(timeout) {
+			t.Fatalf("Timed out waiting for %s", description)
+		}
+		time.Sleep(10 * time.Second)
+	}
+}
+
 func TestCoreBootVolumeAttachmentResource_basic(t *testing.T) {
 	provider := testAccProvider
 	config := testProviderConfig()
@@ -28,7 +103,7 @@ func TestCoreBootVolumeAttachmentResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_core_boot_volume_attachments.test_boot_volume_attachments"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -79,3 +154,62 @@ data "oci_core_boot_volume_attachments" "test_boot_volume_attachments" {
 		},
 	})
 }
+
+func TestCoreBootVolumeAttachmentResource_swap(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_boot_volume_attachment.test_boot_volume_attachment"
+
+	var availabilityDomain, instanceId string
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// create an instance and a clone of its boot volume
+			{
+				Config: config + BootVolumePropertyVariables + compartmentIdVariableStr + BootVolumeCloneResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("oci_core_boot_volume.test_boot_volume", "state", "AVAILABLE"),
+
+					func(s *terraform.State) (err error) {
+						if availabilityDomain, err = fromInstanceState(s, "oci_core_instance.test_instance", "availability_domain"); err != nil {
+							return err
+						}
+						instanceId, err = fromInstanceState(s, "oci_core_instance.test_instance", "id")
+						return err
+					},
+				),
+			},
+			// stop the instance, detach its original boot volume and attach the clone in its place
+			{
+				PreConfig: func() {
+					stopInstanceAndDetachBootVolume(t, availabilityDomain, compartmentId, instanceId)
+				},
+				Config: config + compartmentIdVariableStr + BootVolumeAttachmentSwapResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "availability_domain"),
+					TestCheckResourceAttributesEqual(resourceName, "boot_volume_id", "oci_core_boot_volume.test_boot_volume", "id"),
+					resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "swappedBootVolume"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					TestCheckResourceAttributesEqual(resourceName, "instance_id", "oci_core_instance.test_instance", "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "ATTACHED"),
+					resource.TestCheckResourceAttrSet(resourceName, "time_created"),
+				),
+			},
+			// verify resource import
+			{
+				Config:            config + compartmentIdVariableStr + BootVolumeAttachmentSwapResourceConfig,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
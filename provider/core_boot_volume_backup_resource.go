@@ -0,0 +1,330 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+
+	oci_core "github.com/oracle/oci-go-sdk/core"
+)
+
+func BootVolumeBackupResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: crud.DefaultTimeout,
+		Create:   createBootVolumeBackup,
+		Read:     readBootVolumeBackup,
+		Update:   updateBootVolumeBackup,
+		Delete:   deleteBootVolumeBackup,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"boot_volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"defined_tags": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: definedTagsDiffSuppressFunction,
+				Elem:             schema.TypeString,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"freeform_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size_in_gbs": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"source_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_request_received": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unique_size_in_gbs": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
+	sync := &BootVolumeBackupResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).blockstorageClient
+
+	return crud.CreateResource(d, sync)
+}
+
+func readBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
+	sync := &BootVolumeBackupResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).blockstorageClient
+
+	return crud.ReadResource(sync)
+}
+
+func updateBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
+	sync := &BootVolumeBackupResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).blockstorageClient
+
+	return crud.UpdateResource(d, sync)
+}
+
+func deleteBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
+	sync := &BootVolumeBackupResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).blockstorageClient
+	sync.DisableNotFoundRetries = true
+
+	return crud.DeleteResource(d, sync)
+}
+
+type BootVolumeBackupResourceCrud struct {
+	crud.BaseCrud
+	Client                 *oci_core.BlockstorageClient
+	Res                    *oci_core.BootVolumeBackup
+	DisableNotFoundRetries bool
+}
+
+func (s *BootVolumeBackupResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *BootVolumeBackupResourceCrud) CreatedPending() []string {
+	// Creating is considered "Created" because it can take some time to finish
+	// actually creating and uploading the backup.
+	return []string{
+		string(oci_core.BootVolumeBackupLifecycleStateCreating),
+		string(oci_core.BootVolumeBackupLifecycleStateRequestReceived),
+	}
+}
+
+func (s *BootVolumeBackupResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_core.BootVolumeBackupLifecycleStateAvailable),
+	}
+}
+
+func (s *BootVolumeBackupResourceCrud) DeletedPending() []string {
+	return []string{
+		string(oci_core.BootVolumeBackupLifecycleStateTerminating),
+	}
+}
+
+func (s *BootVolumeBackupResourceCrud) DeletedTarget() []string {
+	return []string{
+		string(oci_core.BootVolumeBackupLifecycleStateTerminated),
+	}
+}
+
+func (s *BootVolumeBackupResourceCrud) Create() error {
+	request := oci_core.CreateBootVolumeBackupRequest{}
+
+	if bootVolumeId, ok := s.D.GetOkExists("boot_volume_id"); ok {
+		tmp := bootVolumeId.(string)
+		request.BootVolumeId = &tmp
+	}
+
+	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
+		convertedDefinedTags, err := mapToDefinedTags(definedTags.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		request.DefinedTags = convertedDefinedTags
+	}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok {
+		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
+	}
+
+	if type_, ok := s.D.GetOkExists("type"); ok {
+		request.Type = oci_core.CreateBootVolumeBackupDetailsTypeEnum(type_.(string))
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.CreateBootVolumeBackup(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.BootVolumeBackup
+	return nil
+}
+
+func (s *BootVolumeBackupResourceCrud) Get() error {
+	request := oci_core.GetBootVolumeBackupRequest{}
+
+	tmp := s.D.Id()
+	request.BootVolumeBackupId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.GetBootVolumeBackup(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.BootVolumeBackup
+	return nil
+}
+
+func (s *BootVolumeBackupResourceCrud) Update() error {
+	request := oci_core.UpdateBootVolumeBackupRequest{}
+
+	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
+		convertedDefinedTags, err := mapToDefinedTags(definedTags.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		request.DefinedTags = convertedDefinedTags
+	}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok {
+		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
+	}
+
+	tmp := s.D.Id()
+	request.BootVolumeBackupId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.UpdateBootVolumeBackup(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.BootVolumeBackup
+	return nil
+}
+
+func (s *BootVolumeBackupResourceCrud) Delete() error {
+	request := oci_core.DeleteBootVolumeBackupRequest{}
+
+	tmp := s.D.Id()
+	request.BootVolumeBackupId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	_, err := s.Client.DeleteBootVolumeBackup(context.Background(), request)
+	return err
+}
+
+func (s *BootVolumeBackupResourceCrud) SetData() {
+	if s.Res.BootVolumeId != nil {
+		s.D.Set("boot_volume_id", *s.Res.BootVolumeId)
+	}
+
+	if s.Res.CompartmentId != nil {
+		s.D.Set("compartment_id", *s.Res.CompartmentId)
+	}
+
+	if s.Res.DefinedTags != nil {
+		s.D.Set("defined_tags", definedTagsToMap(s.Res.DefinedTags))
+	}
+
+	if s.Res.DisplayName != nil {
+		s.D.Set("display_name", *s.Res.DisplayName)
+	}
+
+	if s.Res.ExpirationTime != nil {
+		s.D.Set("expiration_time", s.Res.ExpirationTime.String())
+	}
+
+	s.D.Set("freeform_tags", s.Res.FreeformTags)
+
+	if s.Res.Id != nil {
+		s.D.Set("id", *s.Res.Id)
+	}
+
+	if s.Res.ImageId != nil {
+		s.D.Set("image_id", *s.Res.ImageId)
+	}
+
+	if s.Res.SizeInGBs != nil {
+		s.D.Set("size_in_gbs", *s.Res.SizeInGBs)
+	}
+
+	s.D.Set("source_type", s.Res.SourceType)
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	if s.Res.TimeRequestReceived != nil {
+		s.D.Set("time_request_received", s.Res.TimeRequestReceived.String())
+	}
+
+	s.D.Set("type", s.Res.Type)
+
+	if s.Res.UniqueSizeInGBs != nil {
+		s.D.Set("unique_size_in_gbs", *s.Res.UniqueSizeInGBs)
+	}
+}
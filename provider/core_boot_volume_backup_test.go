@@ -0,0 +1,186 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const (
+	BootVolumeBackupRequiredOnlyResource = BootVolumeBackupResourceDependencies + `
+resource "oci_core_boot_volume_backup" "test_boot_volume_backup" {
+	#Required
+	boot_volume_id = "${oci_core_instance.test_instance.boot_volume_id}"
+}
+`
+
+	BootVolumeBackupResourceConfig = BootVolumeBackupResourceDependencies + `
+resource "oci_core_boot_volume_backup" "test_boot_volume_backup" {
+	#Required
+	boot_volume_id = "${oci_core_instance.test_instance.boot_volume_id}"
+
+	#Optional
+	defined_tags = "${map("${oci_identity_tag_namespace.tag-namespace1.name}.${oci_identity_tag.tag1.name}", "${var.boot_volume_backup_defined_tags_value}")}"
+	display_name = "${var.boot_volume_backup_display_name}"
+	freeform_tags = "${var.boot_volume_backup_freeform_tags}"
+	type = "${var.boot_volume_backup_type}"
+}
+`
+	BootVolumeBackupPropertyVariables = `
+variable "boot_volume_backup_defined_tags_value" { default = "value" }
+variable "boot_volume_backup_display_name" { default = "displayName" }
+variable "boot_volume_backup_freeform_tags" { default = {"Department"= "Finance"} }
+variable "boot_volume_backup_state" { default = "AVAILABLE" }
+variable "boot_volume_backup_type" { default = "FULL" }
+
+`
+	BootVolumeBackupResourceDependencies = DefinedTagsDependencies + BootVolumeResourceDependencies
+)
+
+func TestCoreBootVolumeBackupResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_boot_volume_backup.test_boot_volume_backup"
+	datasourceName := "data.oci_core_boot_volume_backups.test_boot_volume_backups"
+
+	var resId, resId2 string
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + BootVolumeBackupPropertyVariables + compartmentIdVariableStr + BootVolumeBackupRequiredOnlyResource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "boot_volume_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+				),
+			},
+
+			// delete before next create
+			{
+				Config: config + compartmentIdVariableStr + BootVolumeBackupResourceDependencies,
+			},
+			// verify create with optionals
+			{
+				Config: config + BootVolumeBackupPropertyVariables + compartmentIdVariableStr + BootVolumeBackupResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "boot_volume_id"),
+					resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttr(resourceName, "defined_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "displayName"),
+					resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "image_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "size_in_gbs"),
+					resource.TestCheckResourceAttr(resourceName, "source_type", "MANUAL"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet(resourceName, "time_created"),
+					resource.TestCheckResourceAttr(resourceName, "type", "FULL"),
+
+					func(s *terraform.State) (err error) {
+						resId, err = fromInstanceState(s, resourceName, "id")
+						return err
+					},
+				),
+			},
+
+			// verify updates to updatable parameters
+			{
+				Config: config + `
+variable "boot_volume_backup_defined_tags_value" { default = "updatedValue" }
+variable "boot_volume_backup_display_name" { default = "displayName2" }
+variable "boot_volume_backup_freeform_tags" { default = {"Department"= "Accounting"} }
+variable "boot_volume_backup_state" { default = "AVAILABLE" }
+variable "boot_volume_backup_type" { default = "FULL" }
+
+                ` + compartmentIdVariableStr + BootVolumeBackupResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "boot_volume_id"),
+					resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttr(resourceName, "defined_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "displayName2"),
+					resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet(resourceName, "time_created"),
+					resource.TestCheckResourceAttr(resourceName, "type", "FULL"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+			// verify datasource
+			{
+				Config: config + `
+variable "boot_volume_backup_defined_tags_value" { default = "updatedValue" }
+variable "boot_volume_backup_display_name" { default = "displayName2" }
+variable "boot_volume_backup_freeform_tags" { default = {"Department"= "Accounting"} }
+variable "boot_volume_backup_state" { default = "AVAILABLE" }
+variable "boot_volume_backup_type" { default = "FULL" }
+
+data "oci_core_boot_volume_backups" "test_boot_volume_backups" {
+	#Required
+	compartment_id = "${var.compartment_id}"
+
+	#Optional
+	boot_volume_id = "${oci_core_instance.test_instance.boot_volume_id}"
+	display_name = "${var.boot_volume_backup_display_name}"
+	state = "${var.boot_volume_backup_state}"
+
+    filter {
+    	name = "id"
+    	values = ["${oci_core_boot_volume_backup.test_boot_volume_backup.id}"]
+    }
+}
+                ` + compartmentIdVariableStr + BootVolumeBackupResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "boot_volume_id"),
+					resource.TestCheckResourceAttr(datasourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttr(datasourceName, "display_name", "displayName2"),
+					resource.TestCheckResourceAttr(datasourceName, "state", "AVAILABLE"),
+
+					resource.TestCheckResourceAttr(datasourceName, "boot_volume_backups.#", "1"),
+					resource.TestCheckResourceAttrSet(datasourceName, "boot_volume_backups.0.boot_volume_id"),
+					resource.TestCheckResourceAttr(datasourceName, "boot_volume_backups.0.compartment_id", compartmentId),
+					resource.TestCheckResourceAttr(datasourceName, "boot_volume_backups.0.defined_tags.%", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "boot_volume_backups.0.display_name", "displayName2"),
+					resource.TestCheckResourceAttr(datasourceName, "boot_volume_backups.0.freeform_tags.%", "1"),
+					resource.TestCheckResourceAttrSet(datasourceName, "boot_volume_backups.0.id"),
+					resource.TestCheckResourceAttr(datasourceName, "boot_volume_backups.0.state", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet(datasourceName, "boot_volume_backups.0.time_created"),
+					resource.TestCheckResourceAttr(datasourceName, "boot_volume_backups.0.type", "FULL"),
+				),
+			},
+			// verify resource import
+			{
+				Config: config + `
+variable "boot_volume_backup_defined_tags_value" { default = "updatedValue" }
+variable "boot_volume_backup_display_name" { default = "displayName2" }
+variable "boot_volume_backup_freeform_tags" { default = {"Department"= "Accounting"} }
+variable "boot_volume_backup_state" { default = "AVAILABLE" }
+variable "boot_volume_backup_type" { default = "FULL" }
+
+                ` + compartmentIdVariableStr + BootVolumeBackupResourceConfig,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
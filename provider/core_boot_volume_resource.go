@@ -0,0 +1,416 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+
+	oci_core "github.com/oracle/oci-go-sdk/core"
+)
+
+const (
+	BootVolumeSourceDetailsBootVolumeBackupDiscriminator = "bootVolumeBackup"
+	BootVolumeSourceDetailsBootVolumeDiscriminator       = "bootVolume"
+)
+
+func BootVolumeResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: crud.DefaultTimeout,
+		Create:   createBootVolume,
+		Read:     readBootVolume,
+		Update:   updateBootVolume,
+		Delete:   deleteBootVolume,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"availability_domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_details": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					// Polymorphic type with 2 subtypes. Both subtypes have the exact schema (required type & required id).
+					Schema: map[string]*schema.Schema{
+						// Required
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: crud.EqualIgnoreCaseSuppressDiff,
+						},
+
+						// Optional
+
+						// Computed
+					},
+				},
+			},
+
+			// Optional
+			"defined_tags": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: definedTagsDiffSuppressFunction,
+				Elem:             schema.TypeString,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"freeform_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
+			"size_in_gbs": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_hydrated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"size_in_mbs": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createBootVolume(d *schema.ResourceData, m interface{}) error {
+	sync := &BootVolumeResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).blockstorageClient
+
+	return crud.CreateResource(d, sync)
+}
+
+func readBootVolume(d *schema.ResourceData, m interface{}) error {
+	sync := &BootVolumeResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).blockstorageClient
+
+	return crud.ReadResource(sync)
+}
+
+func updateBootVolume(d *schema.ResourceData, m interface{}) error {
+	sync := &BootVolumeResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).blockstorageClient
+
+	return crud.UpdateResource(d, sync)
+}
+
+func deleteBootVolume(d *schema.ResourceData, m interface{}) error {
+	sync := &BootVolumeResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).blockstorageClient
+	sync.DisableNotFoundRetries = true
+
+	return crud.DeleteResource(d, sync)
+}
+
+type BootVolumeResourceCrud struct {
+	crud.BaseCrud
+	Client                 *oci_core.BlockstorageClient
+	Res                    *oci_core.BootVolume
+	DisableNotFoundRetries bool
+}
+
+func (s *BootVolumeResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *BootVolumeResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_core.BootVolumeLifecycleStateProvisioning),
+		string(oci_core.BootVolumeLifecycleStateRestoring),
+	}
+}
+
+func (s *BootVolumeResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_core.BootVolumeLifecycleStateAvailable),
+	}
+}
+
+func (s *BootVolumeResourceCrud) DeletedPending() []string {
+	return []string{
+		string(oci_core.BootVolumeLifecycleStateTerminating),
+	}
+}
+
+func (s *BootVolumeResourceCrud) DeletedTarget() []string {
+	return []string{
+		string(oci_core.BootVolumeLifecycleStateTerminated),
+	}
+}
+
+func (s *BootVolumeResourceCrud) Create() error {
+	request := oci_core.CreateBootVolumeRequest{}
+
+	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
+		tmp := availabilityDomain.(string)
+		request.AvailabilityDomain = &tmp
+	}
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
+		convertedDefinedTags, err := mapToDefinedTags(definedTags.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		request.DefinedTags = convertedDefinedTags
+	}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok {
+		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
+	}
+
+	if sizeInGBs, ok := s.D.GetOkExists("size_in_gbs"); ok {
+		tmp := sizeInGBs.(int)
+		request.SizeInGBs = &tmp
+	}
+
+	if sourceDetails, ok := s.D.GetOkExists("source_details"); ok {
+		request.SourceDetails = mapToBootVolumeSourceDetails(sourceDetails.([]interface{}))
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.CreateBootVolume(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.BootVolume
+	return nil
+}
+
+func (s *BootVolumeResourceCrud) Get() error {
+	request := oci_core.GetBootVolumeRequest{}
+
+	tmp := s.D.Id()
+	request.BootVolumeId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.GetBootVolume(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.BootVolume
+	return nil
+}
+
+func (s *BootVolumeResourceCrud) Update() error {
+	request := oci_core.UpdateBootVolumeRequest{}
+
+	tmp := s.D.Id()
+	request.BootVolumeId = &tmp
+
+	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
+		convertedDefinedTags, err := mapToDefinedTags(definedTags.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		request.DefinedTags = convertedDefinedTags
+	}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok {
+		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.UpdateBootVolume(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.BootVolume
+	return nil
+}
+
+func (s *BootVolumeResourceCrud) Delete() error {
+	request := oci_core.DeleteBootVolumeRequest{}
+
+	tmp := s.D.Id()
+	request.BootVolumeId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	_, err := s.Client.DeleteBootVolume(context.Background(), request)
+	return err
+}
+
+func (s *BootVolumeResourceCrud) SetData() {
+	if s.Res.AvailabilityDomain != nil {
+		s.D.Set("availability_domain", *s.Res.AvailabilityDomain)
+	}
+
+	if s.Res.CompartmentId != nil {
+		s.D.Set("compartment_id", *s.Res.CompartmentId)
+	}
+
+	if s.Res.DefinedTags != nil {
+		s.D.Set("defined_tags", definedTagsToMap(s.Res.DefinedTags))
+	}
+
+	if s.Res.DisplayName != nil {
+		s.D.Set("display_name", *s.Res.DisplayName)
+	}
+
+	s.D.Set("freeform_tags", s.Res.FreeformTags)
+
+	if s.Res.Id != nil {
+		s.D.Set("id", *s.Res.Id)
+	}
+
+	if s.Res.ImageId != nil {
+		s.D.Set("image_id", *s.Res.ImageId)
+	}
+
+	if s.Res.IsHydrated != nil {
+		s.D.Set("is_hydrated", *s.Res.IsHydrated)
+	}
+
+	if s.Res.SizeInGBs != nil {
+		s.D.Set("size_in_gbs", *s.Res.SizeInGBs)
+	}
+
+	if s.Res.SizeInMBs != nil {
+		s.D.Set("size_in_mbs", *s.Res.SizeInMBs)
+	}
+
+	if s.Res.SourceDetails != nil {
+		s.D.Set("source_details", BootVolumeSourceDetailsToMap(s.Res.SourceDetails))
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	if s.Res.VolumeGroupId != nil {
+		s.D.Set("volume_group_id", *s.Res.VolumeGroupId)
+	}
+}
+
+func mapToBootVolumeSourceDetails(rawList []interface{}) oci_core.BootVolumeSourceDetails {
+	var item oci_core.BootVolumeSourceDetails
+
+	if len(rawList) > 0 {
+		rawItem := rawList[0].(map[string]interface{})
+
+		var sourceType string
+		if _type, ok := rawItem["type"]; ok {
+			sourceType = strings.ToLower(_type.(string))
+		}
+
+		id := rawItem["id"].(string)
+
+		switch sourceType {
+		case strings.ToLower(BootVolumeSourceDetailsBootVolumeDiscriminator):
+			item = oci_core.BootVolumeSourceFromBootVolumeDetails{
+				Id: &id,
+			}
+		case strings.ToLower(BootVolumeSourceDetailsBootVolumeBackupDiscriminator):
+			item = oci_core.BootVolumeSourceFromBootVolumeBackupDetails{
+				Id: &id,
+			}
+		}
+	}
+
+	return item
+}
+
+func BootVolumeSourceDetailsToMap(obj oci_core.BootVolumeSourceDetails) []interface{} {
+	sourceDetails := []interface{}{}
+	var item map[string]interface{}
+
+	if details, ok := obj.(oci_core.BootVolumeSourceFromBootVolumeDetails); ok {
+		item = map[string]interface{}{
+			"type": BootVolumeSourceDetailsBootVolumeDiscriminator,
+			"id":   *details.Id,
+		}
+	} else if details, ok := obj.(oci_core.BootVolumeSourceFromBootVolumeBackupDetails); ok {
+		item = map[string]interface{}{
+			"type": BootVolumeSourceDetailsBootVolumeBackupDiscriminator,
+			"id":   *details.Id,
+		}
+	}
+
+	if item != nil {
+		sourceDetails = append(sourceDetails, item)
+	}
+
+	return sourceDetails
+}
@@ -13,11 +13,32 @@ import (
 const (
 	BootVolumeResourceConfig = BootVolumeResourceDependencies + `
 
+`
+	BootVolumeCloneResourceConfig = DefinedTagsDependencies + BootVolumeResourceDependencies + `
+resource "oci_core_boot_volume" "test_boot_volume" {
+	#Required
+	availability_domain = "${oci_core_instance.test_instance.availability_domain}"
+	compartment_id = "${var.compartment_id}"
+	source_details {
+		#Required
+		id = "${oci_core_instance.test_instance.boot_volume_id}"
+		type = "bootVolume"
+	}
+
+	#Optional
+	defined_tags = "${map("${oci_identity_tag_namespace.tag-namespace1.name}.${oci_identity_tag.tag1.name}", "${var.boot_volume_defined_tags_value}")}"
+	display_name = "${var.boot_volume_display_name}"
+	freeform_tags = "${var.boot_volume_freeform_tags}"
+}
 `
 	BootVolumePropertyVariables = `
+variable "boot_volume_defined_tags_value" { default = "value" }
+variable "boot_volume_display_name" { default = "displayName" }
+variable "boot_volume_freeform_tags" { default = {"Department"= "Finance"} }
+
 `
 
-	BootVolumeResourceDependencies = BootVolumePropertyVariables + InstancePropertyVariables + InstanceResourceAsDependencyConfig
+	BootVolumeResourceDependencies = InstancePropertyVariables + InstanceResourceAsDependencyConfig
 )
 
 func TestCoreBootVolumeResource_basic(t *testing.T) {
@@ -29,7 +50,7 @@ func TestCoreBootVolumeResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_core_boot_volumes.test_boot_volumes"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -59,3 +80,78 @@ func TestCoreBootVolumeResource_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestCoreBootVolumeResource_clone(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_boot_volume.test_boot_volume"
+
+	var resId, resId2 string
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + BootVolumePropertyVariables + compartmentIdVariableStr + BootVolumeCloneResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "availability_domain"),
+					resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttr(resourceName, "defined_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "displayName"),
+					resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "size_in_gbs"),
+					resource.TestCheckResourceAttr(resourceName, "source_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_details.0.type", "bootVolume"),
+					TestCheckResourceAttributesEqual(resourceName, "source_details.0.id", "oci_core_instance.test_instance", "boot_volume_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet(resourceName, "time_created"),
+
+					func(s *terraform.State) (err error) {
+						resId, err = fromInstanceState(s, resourceName, "id")
+						return err
+					},
+				),
+			},
+
+			// verify updates to updatable parameters
+			{
+				Config: config + `
+variable "boot_volume_defined_tags_value" { default = "updatedValue" }
+variable "boot_volume_display_name" { default = "displayName2" }
+variable "boot_volume_freeform_tags" { default = {"Department"= "Accounting"} }
+
+				` + compartmentIdVariableStr + BootVolumeCloneResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "defined_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "displayName2"),
+					resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "freeform_tags.Department", "Accounting"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+			// verify resource import
+			{
+				Config:            config + BootVolumePropertyVariables + compartmentIdVariableStr + BootVolumeCloneResourceConfig,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
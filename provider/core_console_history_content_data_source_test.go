@@ -34,7 +34,7 @@ func (s *CoreConsoleHistoryDataDatasourceTestSuite) SetupTest() {
 }
 
 func (s *CoreConsoleHistoryDataDatasourceTestSuite) TestAccDatasourceCoreConsoleHistory_basic() {
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 s.Providers,
 		Steps: []resource.TestStep{
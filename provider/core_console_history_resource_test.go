@@ -39,7 +39,7 @@ func (s *ResourceCoreConsoleHistoryTestSuite) SetupTest() {
 
 func (s *ResourceCoreConsoleHistoryTestSuite) TestAccResourceCoreInstanceConsoleHistory_basic() {
 	var consoleHistoryId string
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			{
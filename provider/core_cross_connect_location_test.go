@@ -29,7 +29,7 @@ func TestCoreCrossConnectLocationResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_core_cross_connect_locations.test_cross_connect_locations"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
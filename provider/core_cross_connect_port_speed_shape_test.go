@@ -29,7 +29,7 @@ func TestCoreCrossConnectPortSpeedShapeResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_core_cross_connect_port_speed_shapes.test_cross_connect_port_speed_shapes"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -36,7 +36,7 @@ func TestCoreCrossConnectStatusResource_basic(t *testing.T) {
 
 	singularDatasourceName := "data.oci_core_cross_connect_status.test_cross_connect_status"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -31,7 +31,7 @@ func (s *DatasourceCoreDHCPOptionsTestSuite) SetupTest() {
 }
 
 func (s *DatasourceCoreDHCPOptionsTestSuite) TestAccDatasourceCoreDHCPOptions_basic() {
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 s.Providers,
 		Steps: []resource.TestStep{
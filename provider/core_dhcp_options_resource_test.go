@@ -106,7 +106,7 @@ func (s *ResourceCoreDHCPOptionsTestSuite) SetupTest() {
 func (s *ResourceCoreDHCPOptionsTestSuite) TestAccResourceCoreDHCPOptions_basic() {
 	var resDefaultId, resOpt4Id, resId2 string
 
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			{
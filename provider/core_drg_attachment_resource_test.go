@@ -39,7 +39,7 @@ func (s *ResourceCoreDrgAttachmentTestSuite) SetupTest() {
 
 func (s *ResourceCoreDrgAttachmentTestSuite) TestAccResourceCoreDrgAttachment_basic() {
 	var resId, resId2 string
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// verify a drg attachment can be created
@@ -30,7 +30,7 @@ func TestCoreFastConnectProviderServiceResource_basic(t *testing.T) {
 	datasourceName := "data.oci_core_fast_connect_provider_services.test_fast_connect_provider_services"
 	singularDatasourceName := "data.oci_core_fast_connect_provider_service.test_fast_connect_provider_service"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
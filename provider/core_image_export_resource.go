@@ -0,0 +1,294 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_core "github.com/oracle/oci-go-sdk/core"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+)
+
+const (
+	ExportImageViaObjectStorageUriDiscriminator   = "objectStorageUri"
+	ExportImageViaObjectStorageTupleDiscriminator = "objectStorageTuple"
+)
+
+// ImageExportResource exports a custom image to Object Storage. Destroying the resource leaves the exported
+// object in place.
+func ImageExportResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: &crud.TwoHours,
+		},
+		Create: createImageExport,
+		Read:   readImageExport,
+		Delete: deleteImageExport,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"destination_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: crud.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					ExportImageViaObjectStorageUriDiscriminator,
+					ExportImageViaObjectStorageTupleDiscriminator,
+				}, true),
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+
+			// ExportImageViaObjectStorageUriDetails
+			"destination_uri": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			// ExportImageViaObjectStorageTupleDetails. These are computed from the destination_uri otherwise.
+			"bucket_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"object_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"object_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createImageExport(d *schema.ResourceData, m interface{}) error {
+	sync := &ImageExportResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+	sync.ObjectStorageClient = m.(*OracleClients).objectStorageClient
+
+	return crud.CreateResource(d, sync)
+}
+
+func readImageExport(d *schema.ResourceData, m interface{}) error {
+	sync := &ImageExportResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+	sync.ObjectStorageClient = m.(*OracleClients).objectStorageClient
+
+	return crud.ReadResource(sync)
+}
+
+func deleteImageExport(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+type ImageExportResourceCrud struct {
+	crud.BaseCrud
+	Client                 *oci_core.ComputeClient
+	ObjectStorageClient    *oci_object_storage.ObjectStorageClient
+	Res                    *oci_core.Image
+	ObjectSize             *int
+	ObjectMissing          bool
+	DisableNotFoundRetries bool
+}
+
+func (s *ImageExportResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *ImageExportResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_core.ImageLifecycleStateExporting),
+	}
+}
+
+func (s *ImageExportResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_core.ImageLifecycleStateAvailable),
+	}
+}
+
+func (s *ImageExportResourceCrud) Create() error {
+	request := oci_core.ExportImageRequest{}
+
+	if imageId, ok := s.D.GetOkExists("image_id"); ok {
+		tmp := imageId.(string)
+		request.ImageId = &tmp
+	}
+
+	details, err := s.mapToExportImageDetails()
+	if err != nil {
+		return err
+	}
+	request.ExportImageDetails = details
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.ExportImage(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Image
+	return nil
+}
+
+func (s *ImageExportResourceCrud) Get() error {
+	request := oci_core.GetImageRequest{}
+
+	tmp := s.D.Get("image_id").(string)
+	request.ImageId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.GetImage(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Image
+
+	// The exported object is only complete once the image is available again
+	if s.Res.LifecycleState == oci_core.ImageLifecycleStateAvailable {
+		s.getExportedObject()
+	}
+
+	return nil
+}
+
+// getExportedObject looks up the size of the exported object. Objects exported through a pre-authenticated request
+// may live in a bucket these credentials can't read, so failing to find the size isn't treated as an error.
+func (s *ImageExportResourceCrud) getExportedObject() {
+	namespaceName, bucketName, objectName, err := s.exportedObjectLocation()
+	if err != nil {
+		log.Printf("[WARN] Unable to determine the location of the exported image: %v", err)
+		return
+	}
+
+	request := oci_object_storage.HeadObjectRequest{
+		NamespaceName: &namespaceName,
+		BucketName:    &bucketName,
+		ObjectName:    &objectName,
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(true, "object_storage")
+
+	response, err := s.ObjectStorageClient.HeadObject(context.Background(), request)
+	if err != nil {
+		if serviceError, ok := oci_common.IsServiceError(err); ok && serviceError.GetHTTPStatusCode() == 404 && !s.exportedThroughUri() {
+			s.ObjectMissing = true
+			return
+		}
+		log.Printf("[WARN] Unable to get the exported image object '%s' in bucket '%s': %v", objectName, bucketName, err)
+		return
+	}
+
+	s.ObjectSize = response.ContentLength
+}
+
+func (s *ImageExportResourceCrud) SetData() {
+	if s.ObjectMissing {
+		// The exported object was removed, so export the image again on the next apply
+		log.Printf("[DEBUG] Exported image object no longer exists, removing image export from state")
+		s.VoidState()
+		return
+	}
+
+	if s.Res.Id != nil {
+		s.D.Set("image_id", *s.Res.Id)
+	}
+
+	if namespaceName, bucketName, objectName, err := s.exportedObjectLocation(); err == nil {
+		s.D.Set("namespace_name", namespaceName)
+		s.D.Set("bucket_name", bucketName)
+		s.D.Set("object_name", objectName)
+	}
+
+	if s.ObjectSize != nil {
+		s.D.Set("object_size_in_bytes", *s.ObjectSize)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+}
+
+func (s *ImageExportResourceCrud) exportedObjectLocation() (namespaceName string, bucketName string, objectName string, err error) {
+	if s.exportedThroughUri() {
+		return parseObjectStorageUri(s.D.Get("destination_uri").(string))
+	}
+
+	return s.D.Get("namespace_name").(string), s.D.Get("bucket_name").(string), s.D.Get("object_name").(string), nil
+}
+
+func (s *ImageExportResourceCrud) exportedThroughUri() bool {
+	return strings.EqualFold(s.D.Get("destination_type").(string), ExportImageViaObjectStorageUriDiscriminator)
+}
+
+func (s *ImageExportResourceCrud) mapToExportImageDetails() (oci_core.ExportImageDetails, error) {
+	destinationType := s.D.Get("destination_type").(string)
+
+	switch strings.ToLower(destinationType) {
+	case strings.ToLower(ExportImageViaObjectStorageUriDiscriminator):
+		destinationUri, ok := s.D.GetOkExists("destination_uri")
+		if !ok {
+			return nil, fmt.Errorf("destination_uri is required when destination_type is '%s'", ExportImageViaObjectStorageUriDiscriminator)
+		}
+		tmp := destinationUri.(string)
+		return oci_core.ExportImageViaObjectStorageUriDetails{DestinationUri: &tmp}, nil
+	case strings.ToLower(ExportImageViaObjectStorageTupleDiscriminator):
+		result := oci_core.ExportImageViaObjectStorageTupleDetails{}
+
+		for _, field := range []string{"bucket_name", "namespace_name", "object_name"} {
+			if _, ok := s.D.GetOkExists(field); !ok {
+				return nil, fmt.Errorf("%s is required when destination_type is '%s'", field, ExportImageViaObjectStorageTupleDiscriminator)
+			}
+		}
+
+		bucketName := s.D.Get("bucket_name").(string)
+		result.BucketName = &bucketName
+
+		namespaceName := s.D.Get("namespace_name").(string)
+		result.NamespaceName = &namespaceName
+
+		objectName := s.D.Get("object_name").(string)
+		result.ObjectName = &objectName
+
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unknown destination_type '%s'", destinationType)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const (
+	ImageExportTupleResourceConfig = ImageExportResourceDependencies + `
+resource "oci_core_image_export" "test_image_export" {
+	#Required
+	destination_type = "objectStorageTuple"
+	image_id = "${oci_core_image.test_image.id}"
+
+	#Optional
+	bucket_name = "${oci_objectstorage_bucket.test_bucket.name}"
+	namespace_name = "${oci_objectstorage_bucket.test_bucket.namespace}"
+	object_name = "exports/test-image-export-tuple"
+}
+`
+
+	ImageExportUriResourceConfig = ImageExportResourceDependencies + `
+resource "oci_objectstorage_preauthrequest" "test_preauthenticated_request" {
+	access_type = "AnyObjectWrite"
+	bucket = "${oci_objectstorage_bucket.test_bucket.name}"
+	name = "test-image-export"
+	namespace = "${oci_objectstorage_bucket.test_bucket.namespace}"
+	time_expires = "${var.image_export_par_time_expires}"
+}
+
+resource "oci_core_image_export" "test_image_export" {
+	#Required
+	destination_type = "objectStorageUri"
+	image_id = "${oci_core_image.test_image.id}"
+
+	#Optional
+	destination_uri = "https://objectstorage.${var.region}.oraclecloud.com${oci_objectstorage_preauthrequest.test_preauthenticated_request.access_uri}test-image-export-uri"
+}
+`
+
+	ImageExportResourceDependencies = ImageRequiredOnlyResource + BucketResourceDependencies + `
+resource "oci_objectstorage_bucket" "test_bucket" {
+	compartment_id = "${var.compartment_id}"
+	name = "tf-test-image-export"
+	namespace = "${data.oci_objectstorage_namespace.t.namespace}"
+}
+`
+)
+
+func TestCoreImageExportResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+	parExpiryVariableStr := fmt.Sprintf("variable \"image_export_par_time_expires\" { default = \"%s\" }\n", time.Now().Add(24*time.Hour).UTC().Format(time.RFC3339))
+
+	resourceName := "oci_core_image_export.test_image_export"
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify export to a bucket and object
+			{
+				Config: config + compartmentIdVariableStr + ImageExportTupleResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket_name", "tf-test-image-export"),
+					TestCheckResourceAttributesEqual(resourceName, "image_id", "oci_core_image.test_image", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "namespace_name"),
+					resource.TestCheckResourceAttr(resourceName, "object_name", "exports/test-image-export-tuple"),
+					resource.TestCheckResourceAttrSet(resourceName, "object_size_in_bytes"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+				),
+			},
+			// verify export through a pre-authenticated request
+			{
+				Config: config + compartmentIdVariableStr + parExpiryVariableStr + ImageExportUriResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket_name", "tf-test-image-export"),
+					resource.TestCheckResourceAttrSet(resourceName, "destination_uri"),
+					resource.TestCheckResourceAttrSet(resourceName, "namespace_name"),
+					resource.TestCheckResourceAttr(resourceName, "object_name", "test-image-export-uri"),
+					resource.TestCheckResourceAttrSet(resourceName, "object_size_in_bytes"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+				),
+			},
+		},
+	})
+}
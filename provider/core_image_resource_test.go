@@ -27,7 +27,7 @@ func (s *ResourceCoreImageTestSuite) SetupTest() {
 
 func (s *ResourceCoreImageTestSuite) TestAccResourceCoreImage_basic() {
 	var resId, resId2 string
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// create image
@@ -158,7 +158,7 @@ func (s *ResourceCoreImageTestSuite) TestAccResourceCoreImage_createFromExport_o
 
 	imagePar := ""
 
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			{
@@ -194,7 +194,7 @@ func (s *ResourceCoreImageTestSuite) TestAccResourceCoreImage_createFromExport_o
 	 */
 	s.T().Skip("Long running test, requires per tenancy namespace + bucket + image export object to run")
 
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			{
@@ -32,7 +32,7 @@ func (s *DatasourceCoreImageTestSuite) SetupTest() {
 }
 
 func (s *DatasourceCoreImageTestSuite) TestAccImage_basic() {
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 s.Providers,
 		Steps: []resource.TestStep{
@@ -0,0 +1,155 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+
+	oci_core "github.com/oracle/oci-go-sdk/core"
+)
+
+const (
+	// A reset can take the instance from RUNNING back to RUNNING between two polls, so it is polled often, and assumed
+	// to have been reset if it isn't seen to leave RUNNING within the settle window
+	instanceActionPollInterval = 2 * time.Second
+	instanceActionSettleWindow = 2 * time.Minute
+)
+
+// InstanceActionResource performs a one-off power action, such as a reset, on an existing instance.
+func InstanceActionResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: crud.DefaultTimeout,
+		Create:   createInstanceAction,
+		Read:     readInstanceAction,
+		Delete:   deleteInstanceAction,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: crud.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.InstanceActionActionSoftreset),
+					string(oci_core.InstanceActionActionReset),
+				}, true),
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createInstanceAction(d *schema.ResourceData, m interface{}) error {
+	sync := &InstanceActionResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+
+	return crud.CreateResource(d, sync)
+}
+
+func readInstanceAction(d *schema.ResourceData, m interface{}) error {
+	sync := &InstanceActionResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).computeClient
+
+	return crud.ReadResource(sync)
+}
+
+func deleteInstanceAction(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+type InstanceActionResourceCrud struct {
+	crud.BaseCrud
+	Client                 *oci_core.ComputeClient
+	Res                    *oci_core.Instance
+	DisableNotFoundRetries bool
+}
+
+func (s *InstanceActionResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *InstanceActionResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_core.InstanceLifecycleStateStopping),
+		string(oci_core.InstanceLifecycleStateStopped),
+		string(oci_core.InstanceLifecycleStateStarting),
+	}
+}
+
+func (s *InstanceActionResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_core.InstanceLifecycleStateRunning),
+	}
+}
+
+func (s *InstanceActionResourceCrud) Create() error {
+	request := oci_core.InstanceActionRequest{}
+
+	if action, ok := s.D.GetOkExists("action"); ok {
+		request.Action = oci_core.InstanceActionActionEnum(strings.ToUpper(action.(string)))
+	}
+
+	if instanceId, ok := s.D.GetOkExists("instance_id"); ok {
+		tmp := instanceId.(string)
+		request.InstanceId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.InstanceAction(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Instance
+
+	// The instance is usually still RUNNING when the action is accepted, which is also the target state. Wait for the
+	// action to start, so that waiting for the target state doesn't return before the instance is reset.
+	actionStartedFunc := func() bool { return s.Res.LifecycleState != oci_core.InstanceLifecycleStateRunning }
+	return crud.WaitForActionStarted(s, actionStartedFunc, instanceActionPollInterval, instanceActionSettleWindow)
+}
+
+func (s *InstanceActionResourceCrud) Get() error {
+	request := oci_core.GetInstanceRequest{}
+
+	tmp := s.D.Get("instance_id").(string)
+	request.InstanceId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.GetInstance(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Instance
+	return nil
+}
+
+func (s *InstanceActionResourceCrud) SetData() {
+	if s.Res.Id != nil {
+		s.D.Set("instance_id", *s.Res.Id)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+}
@@ -49,7 +49,7 @@ func TestCoreInstanceConsoleConnectionResource_basic(t *testing.T) {
 	resourceName := "oci_core_instance_console_connection.test_instance_console_connection"
 	datasourceName := "data.oci_core_instance_console_connections.test_instance_console_connections"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
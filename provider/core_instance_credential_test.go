@@ -75,7 +75,7 @@ func TestCoreInstanceCredentialResource_basic(t *testing.T) {
 
 	singularDatasourceName := "data.oci_core_instance_credentials.test_instance_credentials"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
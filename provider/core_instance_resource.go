@@ -10,6 +10,7 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -286,8 +287,14 @@ func InstanceResource() *schema.Resource {
 				Computed: true,
 			},
 			"state": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: crud.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.InstanceLifecycleStateRunning),
+					string(oci_core.InstanceLifecycleStateStopped),
+				}, true),
 			},
 			"time_created": {
 				Type:     schema.TypeString,
@@ -313,7 +320,27 @@ func createInstance(d *schema.ResourceData, m interface{}) error {
 	sync.VirtualNetworkClient = m.(*OracleClients).virtualNetworkClient
 	sync.BlockStorageClient = m.(*OracleClients).blockstorageClient
 
-	return crud.CreateResource(d, sync)
+	// Instances are always launched in the RUNNING state, so remember the requested power state before
+	// it gets overwritten by the refresh that follows the launch.
+	var desiredState string
+	if state, ok := d.GetOkExists("state"); ok {
+		desiredState = strings.ToUpper(state.(string))
+	}
+
+	if err := crud.CreateResource(d, sync); err != nil {
+		return err
+	}
+
+	if desiredState == "" || desiredState == string(sync.Res.LifecycleState) {
+		return nil
+	}
+
+	if err := sync.updatePowerState(oci_core.InstanceLifecycleStateEnum(desiredState), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	sync.SetData()
+	return nil
 }
 
 func readInstance(d *schema.ResourceData, m interface{}) error {
@@ -496,6 +523,14 @@ func (s *InstanceResourceCrud) Get() error {
 }
 
 func (s *InstanceResourceCrud) Update() error {
+	if state, ok := s.D.GetOkExists("state"); ok && s.D.HasChange("state") {
+		desiredState := oci_core.InstanceLifecycleStateEnum(strings.ToUpper(state.(string)))
+		if err := s.updatePowerState(desiredState, s.D.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+		s.D.SetPartial("state")
+	}
+
 	request := oci_core.UpdateInstanceRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -563,6 +598,37 @@ func (s *InstanceResourceCrud) Update() error {
 	return nil
 }
 
+// updatePowerState starts or stops the instance and waits until it reaches the desired lifecycle state.
+func (s *InstanceResourceCrud) updatePowerState(desiredState oci_core.InstanceLifecycleStateEnum, timeout time.Duration) error {
+	var action oci_core.InstanceActionActionEnum
+	switch desiredState {
+	case oci_core.InstanceLifecycleStateRunning:
+		action = oci_core.InstanceActionActionStart
+	case oci_core.InstanceLifecycleStateStopped:
+		action = oci_core.InstanceActionActionStop
+	default:
+		return fmt.Errorf("unsupported instance state '%s', must be one of '%s' or '%s'", desiredState, oci_core.InstanceLifecycleStateRunning, oci_core.InstanceLifecycleStateStopped)
+	}
+
+	request := oci_core.InstanceActionRequest{}
+
+	tmp := s.D.Id()
+	request.InstanceId = &tmp
+	request.Action = action
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.InstanceAction(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Instance
+
+	powerStateReached := func() bool { return s.Res.LifecycleState == desiredState }
+	return crud.WaitForResourceCondition(s, powerStateReached, timeout)
+}
+
 func (s *InstanceResourceCrud) Delete() error {
 	request := oci_core.TerminateInstanceRequest{}
 
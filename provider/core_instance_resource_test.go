@@ -4,12 +4,17 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 
 	"regexp"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/terraform"
+	oci_common "github.com/oracle/oci-go-sdk/common"
 	"github.com/oracle/oci-go-sdk/core"
 	"github.com/stretchr/testify/suite"
 
@@ -69,7 +74,7 @@ func (s *ResourceCoreInstanceTestSuite) TestAccResourceCoreInstance_basic() {
 	var instanceId string
 	vnicResourceName := "data.oci_core_vnic.t"
 
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// verify create
@@ -777,14 +782,140 @@ func (s *ResourceCoreInstanceTestSuite) TestAccResourceCoreInstance_preserveBoot
 	}
 
 	testStepsReference = testSteps
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps:     testSteps,
 	})
 }
 
+func (s *ResourceCoreInstanceTestSuite) TestAccResourceCoreInstance_powerState() {
+	var instanceId string
+
+	instanceConfig := `
+				resource "oci_core_instance" "t" {
+					availability_domain = "${data.oci_identity_availability_domains.ADs.availability_domains.0.name}"
+					compartment_id = "${var.compartment_id}"
+					subnet_id = "${oci_core_subnet.t.id}"
+					image = "${var.InstanceImageOCID[var.region]}"
+					shape = "VM.Standard1.1"
+					state = "${var.instance_state}"
+					metadata {
+						ssh_authorized_keys = "${var.ssh_public_key}"
+					}
+					timeouts {
+						create = "15m"
+					}
+				}`
+
+	resourceTest(s.T(), resource.TestCase{
+		Providers: s.Providers,
+		Steps: []resource.TestStep{
+			// verify an instance can be launched directly into the stopped state
+			{
+				Config: s.Config + `
+				variable "instance_state" { default = "STOPPED" }` + instanceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(s.ResourceName, "state", string(core.InstanceLifecycleStateStopped)),
+					func(ts *terraform.State) (err error) {
+						instanceId, err = fromInstanceState(ts, s.ResourceName, "id")
+						return err
+					},
+				),
+			},
+			// verify the instance is started in place
+			{
+				Config: s.Config + `
+				variable "instance_state" { default = "running" }` + instanceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(s.ResourceName, "state", string(core.InstanceLifecycleStateRunning)),
+					func(ts *terraform.State) (err error) {
+						newId, err := fromInstanceState(ts, s.ResourceName, "id")
+						if newId != instanceId {
+							return fmt.Errorf("Expected same instance ocid, got different.")
+						}
+						return err
+					},
+				),
+			},
+			// verify a one-off reset of the running instance
+			{
+				Config: s.Config + `
+				variable "instance_state" { default = "RUNNING" }` + instanceConfig + `
+				resource "oci_core_instance_action" "t" {
+					instance_id = "${oci_core_instance.t.id}"
+					action = "SOFTRESET"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("oci_core_instance_action.t", "instance_id", s.ResourceName, "id"),
+					resource.TestCheckResourceAttr("oci_core_instance_action.t", "action", "SOFTRESET"),
+					resource.TestCheckResourceAttr("oci_core_instance_action.t", "state", string(core.InstanceLifecycleStateRunning)),
+				),
+			},
+			// verify the instance is stopped in place
+			{
+				Config: s.Config + `
+				variable "instance_state" { default = "STOPPED" }` + instanceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(s.ResourceName, "state", string(core.InstanceLifecycleStateStopped)),
+					func(ts *terraform.State) (err error) {
+						newId, err := fromInstanceState(ts, s.ResourceName, "id")
+						if newId != instanceId {
+							return fmt.Errorf("Expected same instance ocid, got different.")
+						}
+						return err
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestIsStatefulResource(t *testing.T) {
 	var _ crud.StatefulResource = (*InstanceResourceCrud)(nil)
+	var _ crud.StatefullyCreatedResource = (*InstanceActionResourceCrud)(nil)
+}
+
+// The instance is still RUNNING when the reset is accepted, and only reaches RUNNING again after the reset
+func TestUnitInstanceActionWaitsForReset(t *testing.T) {
+	states := []string{"RUNNING", "RUNNING", "STOPPING", "STARTING", "RUNNING"}
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count := int(atomic.AddInt32(&requests, 1))
+		if count > len(states) {
+			count = len(states)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id": "ocid1.instance.oc1..test", "lifecycleState": "%s"}`, states[count-1])
+	}))
+	defer server.Close()
+
+	client := core.ComputeClient{BaseClient: oci_common.DefaultBaseClientWithSigner(testRequestSigner{})}
+	client.Host = server.URL
+	client.UserAgent = "test"
+
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"action":      "RESET",
+		"instance_id": "ocid1.instance.oc1..test",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	actionResource := InstanceActionResource()
+	diff, err := actionResource.Diff(nil, terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	state, err := actionResource.Apply(nil, diff, &OracleClients{computeClient: &client})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if int(atomic.LoadInt32(&requests)) != len(states) {
+		t.Errorf("Expected the instance to be polled until it is RUNNING again, got %d requests", requests)
+	}
+	if state.Attributes["state"] != "RUNNING" {
+		t.Errorf("Expected the state RUNNING, got %s", state.Attributes["state"])
+	}
 }
 
 func TestResourceCoreInstanceTestSuite(t *testing.T) {
@@ -78,7 +78,7 @@ func (s *DatasourceCoreInstanceTestSuite) SetupTest() {
 
 func (s *DatasourceCoreInstanceTestSuite) TestAccDatasourceCoreInstance_basic() {
 
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 s.Providers,
 		Steps: []resource.TestStep{
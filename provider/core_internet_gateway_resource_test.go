@@ -35,7 +35,7 @@ func (s *ResourceCoreInternetGatewayTestSuite) SetupTest() {
 func (s *ResourceCoreInternetGatewayTestSuite) TestAccResourceCoreInternetGateway_basic() {
 	var resId, resId2 string
 	compartmentId := getCompartmentIDForLegacyTests()
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// verify create
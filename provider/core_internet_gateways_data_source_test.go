@@ -36,7 +36,7 @@ func (s *DatasourceCoreInternetGatewayTestSuite) SetupTest() {
 }
 
 func (s *DatasourceCoreInternetGatewayTestSuite) TestAccDatasourceCoreInternetGateway_basic() {
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 s.Providers,
 		Steps: []resource.TestStep{
@@ -45,7 +45,7 @@ func (s *DatasourceCoreIPSecConnectionConfigTestSuite) SetupTest() {
 }
 
 func (s *DatasourceCoreIPSecConnectionConfigTestSuite) TestAccDatasourceCoreIPSecConnectionConfig_basic() {
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 s.Providers,
 		Steps: []resource.TestStep{
@@ -29,7 +29,7 @@ func TestCoreIpSecConnectionDeviceConfigResource_basic(t *testing.T) {
 
 	singularDatasourceName := "data.oci_core_ipsec_config.test_ip_sec_connection_device_config"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
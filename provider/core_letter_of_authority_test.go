@@ -36,7 +36,7 @@ func TestCoreLetterOfAuthorityResource_basic(t *testing.T) {
 
 	singularDatasourceName := "data.oci_core_letter_of_authority.test_letter_of_authority"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
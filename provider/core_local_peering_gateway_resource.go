@@ -379,7 +379,7 @@ func waitForLPGPeeringStatusShouldRetry(timeout time.Duration) func(response com
 		}
 		if getLocalPeeringGatewayResponse, ok := response.Response.(oci_core.GetLocalPeeringGatewayResponse); ok {
 			if getLocalPeeringGatewayResponse.PeeringStatus == oci_core.LocalPeeringGatewayPeeringStatusPending {
-				timeWaited := getTimeWaited(response.AttemptNumber)
+				timeWaited := getTimeWaited(response)
 				return timeWaited < timeout
 			}
 		}
@@ -29,7 +29,7 @@ func TestCorePeerRegionForRemotePeeringResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_core_peer_region_for_remote_peerings.test_peer_region_for_remote_peerings"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
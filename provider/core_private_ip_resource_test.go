@@ -33,7 +33,7 @@ func (s *ResourcePrivateIPTestSuite) SetupTest() {
 
 func (s *ResourcePrivateIPTestSuite) TestAccCoreResourcePrivateIP_basic() {
 	var resId string
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// test create
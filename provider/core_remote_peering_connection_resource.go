@@ -354,7 +354,7 @@ func waitForRPCPeeringStatusShouldRetry(timeout time.Duration) func(response oci
 		}
 		if getRemotePeeringConnectionResponse, ok := response.Response.(oci_core.GetRemotePeeringConnectionResponse); ok {
 			if getRemotePeeringConnectionResponse.PeeringStatus == oci_core.RemotePeeringConnectionPeeringStatusPending {
-				timeWaited := getTimeWaited(response.AttemptNumber)
+				timeWaited := getTimeWaited(response)
 				return timeWaited < timeout
 			}
 		}
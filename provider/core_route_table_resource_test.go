@@ -50,7 +50,7 @@ func (s *ResourceCoreRouteTableTestSuite) SetupTest() {
 }
 
 func (s *ResourceCoreRouteTableTestSuite) TestAccResourceCoreRouteTable_basic() {
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// verify create without rules
@@ -38,7 +38,7 @@ func (s *DatasourceCoreRouteTableTestSuite) SetupTest() {
 
 func (s *DatasourceCoreRouteTableTestSuite) TestAccDatasourceRouteTable_basic() {
 	compartmentID := getCompartmentIDForLegacyTests()
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 s.Providers,
 		Steps: []resource.TestStep{
@@ -37,7 +37,7 @@ func (s *DatasourceCoreSecurityListTestSuite) SetupTest() {
 }
 
 func (s *DatasourceCoreSecurityListTestSuite) TestAccDatasourceCoreSecurityLists_basic() {
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 s.Providers,
 		Steps: []resource.TestStep{
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -32,28 +33,6 @@ func ServiceGatewayResource() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"services": {
-				Type:     schema.TypeSet,
-				Required: true,
-				Set:      servicesHashCodeForSets,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						// Required
-						"service_id": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-
-						// Optional
-
-						// Computed
-						"service_name": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-					},
-				},
-			},
 			"vcn_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -79,6 +58,30 @@ func ServiceGatewayResource() *schema.Resource {
 				Computed: true,
 				Elem:     schema.TypeString,
 			},
+			// Services attached with oci_core_service_gateway_service_attachment are only left alone if this is omitted
+			"services": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Set:      servicesHashCodeForSets,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"service_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						// Optional
+
+						// Computed
+						"service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 
 			// Computed
 			"block_traffic": {
@@ -145,6 +148,11 @@ func (s *ServiceGatewayResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
+// Use a per-gateway mutex to synchronize updates of the services with oci_core_service_gateway_service_attachment.
+func (s *ServiceGatewayResourceCrud) GetMutex() *sync.Mutex {
+	return serviceGatewayMutexes.GetOrCreateMutex(s.D.Id())
+}
+
 func (s *ServiceGatewayResourceCrud) CreatedPending() []string {
 	return []string{
 		string(oci_core.ServiceGatewayLifecycleStateProvisioning),
@@ -266,15 +274,19 @@ func (s *ServiceGatewayResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ServiceGatewayId = &tmp
 
-	request.Services = []oci_core.ServiceIdRequestDetails{}
-	if services, ok := s.D.GetOkExists("services"); ok {
-		set := services.(*schema.Set)
-		interfaces := set.List()
-		tmp := make([]oci_core.ServiceIdRequestDetails, len(interfaces))
-		for i, toBeConverted := range interfaces {
-			tmp[i] = mapToServiceIdRequestDetails(toBeConverted.(map[string]interface{}))
+	// The services replace the ones of the gateway, only send them when they change so that services attached with
+	// oci_core_service_gateway_service_attachment in the meantime aren't detached.
+	if s.D.HasChange("services") {
+		request.Services = []oci_core.ServiceIdRequestDetails{}
+		if services, ok := s.D.GetOkExists("services"); ok {
+			set := services.(*schema.Set)
+			interfaces := set.List()
+			tmp := make([]oci_core.ServiceIdRequestDetails, len(interfaces))
+			for i, toBeConverted := range interfaces {
+				tmp[i] = mapToServiceIdRequestDetails(toBeConverted.(map[string]interface{}))
+			}
+			request.Services = tmp
 		}
-		request.Services = tmp
 	}
 
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
@@ -0,0 +1,211 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_core "github.com/oracle/oci-go-sdk/core"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+)
+
+// Attaching or detaching a service replaces the services of the gateway, so concurrent changes to the same gateway
+// are serialized.
+var serviceGatewayMutexes SafeMutexMap
+
+// ServiceGatewayServiceAttachmentResource enables a single service on an existing service gateway, so that the
+// gateway and its services can be managed in different configurations.
+func ServiceGatewayServiceAttachmentResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: ImportServiceGatewayServiceAttachment,
+		},
+		Timeouts: crud.DefaultTimeout,
+		Create:   createServiceGatewayServiceAttachment,
+		Read:     readServiceGatewayServiceAttachment,
+		Delete:   deleteServiceGatewayServiceAttachment,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"service_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createServiceGatewayServiceAttachment(d *schema.ResourceData, m interface{}) error {
+	sync := &ServiceGatewayServiceAttachmentResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).virtualNetworkClient
+
+	return crud.CreateResource(d, sync)
+}
+
+func readServiceGatewayServiceAttachment(d *schema.ResourceData, m interface{}) error {
+	sync := &ServiceGatewayServiceAttachmentResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).virtualNetworkClient
+
+	return crud.ReadResource(sync)
+}
+
+func deleteServiceGatewayServiceAttachment(d *schema.ResourceData, m interface{}) error {
+	sync := &ServiceGatewayServiceAttachmentResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).virtualNetworkClient
+	sync.DisableNotFoundRetries = true
+
+	return crud.DeleteResource(d, sync)
+}
+
+// ImportServiceGatewayServiceAttachment accepts IDs in the form 'serviceGateways/{serviceGatewayId}/services/{serviceId}'.
+func ImportServiceGatewayServiceAttachment(d *schema.ResourceData, value interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 4 || parts[0] != "serviceGateways" || parts[2] != "services" {
+		return nil, fmt.Errorf("illegal import ID '%s', expected 'serviceGateways/{serviceGatewayId}/services/{serviceId}'", d.Id())
+	}
+
+	d.Set("service_gateway_id", parts[1])
+	d.Set("service_id", parts[3])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type ServiceGatewayServiceAttachmentResourceCrud struct {
+	crud.BaseCrud
+	Client                 *oci_core.VirtualNetworkClient
+	Res                    *oci_core.ServiceGateway
+	DisableNotFoundRetries bool
+}
+
+func (s *ServiceGatewayServiceAttachmentResourceCrud) ID() string {
+	return fmt.Sprintf("serviceGateways/%s/services/%s", s.D.Get("service_gateway_id").(string), s.D.Get("service_id").(string))
+}
+
+// Use a per-gateway mutex to synchronize the attachments of the same gateway.
+func (s *ServiceGatewayServiceAttachmentResourceCrud) GetMutex() *sync.Mutex {
+	return serviceGatewayMutexes.GetOrCreateMutex(s.D.Get("service_gateway_id").(string))
+}
+
+// The state is the one of the gateway, which goes back to AVAILABLE once the service is attached.
+func (s *ServiceGatewayServiceAttachmentResourceCrud) State() string {
+	return string(s.Res.LifecycleState)
+}
+
+func (s *ServiceGatewayServiceAttachmentResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_core.ServiceGatewayLifecycleStateProvisioning),
+	}
+}
+
+func (s *ServiceGatewayServiceAttachmentResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_core.ServiceGatewayLifecycleStateAvailable),
+	}
+}
+
+func (s *ServiceGatewayServiceAttachmentResourceCrud) Create() error {
+	request := oci_core.AttachServiceIdRequest{}
+
+	serviceGatewayId := s.D.Get("service_gateway_id").(string)
+	request.ServiceGatewayId = &serviceGatewayId
+
+	serviceId := s.D.Get("service_id").(string)
+	request.AttachServiceDetails.ServiceId = &serviceId
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.AttachServiceId(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.ServiceGateway
+	return nil
+}
+
+func (s *ServiceGatewayServiceAttachmentResourceCrud) Get() error {
+	request := oci_core.GetServiceGatewayRequest{}
+
+	serviceGatewayId := s.D.Get("service_gateway_id").(string)
+	request.ServiceGatewayId = &serviceGatewayId
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.GetServiceGateway(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.ServiceGateway
+	return nil
+}
+
+// Delete detaches the service and waits for the gateway to be AVAILABLE again, so that the gateway can be modified
+// or deleted once the attachment is gone.
+func (s *ServiceGatewayServiceAttachmentResourceCrud) Delete() error {
+	request := oci_core.DetachServiceIdRequest{}
+
+	serviceGatewayId := s.D.Get("service_gateway_id").(string)
+	request.ServiceGatewayId = &serviceGatewayId
+
+	serviceId := s.D.Get("service_id").(string)
+	request.DetachServiceDetails.ServiceId = &serviceId
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	if _, err := s.Client.DetachServiceId(context.Background(), request); err != nil {
+		return err
+	}
+
+	availableFunc := func() bool {
+		return s.Res.LifecycleState == oci_core.ServiceGatewayLifecycleStateAvailable
+	}
+	return crud.WaitForResourceCondition(s, availableFunc, s.D.Timeout(schema.TimeoutDelete))
+}
+
+func (s *ServiceGatewayServiceAttachmentResourceCrud) SetData() {
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.LifecycleState == oci_core.ServiceGatewayLifecycleStateTerminating || s.Res.LifecycleState == oci_core.ServiceGatewayLifecycleStateTerminated {
+		log.Printf("[DEBUG] Service gateway '%s' has been deleted, removing the attachment of service '%s'", s.D.Get("service_gateway_id").(string), s.D.Get("service_id").(string))
+		s.VoidState()
+		return
+	}
+
+	serviceId := s.D.Get("service_id").(string)
+	for _, service := range s.Res.Services {
+		if service.ServiceId != nil && *service.ServiceId == serviceId {
+			if service.ServiceName != nil {
+				s.D.Set("service_name", *service.ServiceName)
+			}
+			return
+		}
+	}
+
+	log.Printf("[DEBUG] Service '%s' has been detached from service gateway '%s' outside of Terraform", serviceId, s.D.Get("service_gateway_id").(string))
+	s.VoidState()
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const (
+	ServiceGatewayServiceAttachmentResourceConfig = ServiceGatewayServiceAttachmentResourceDependencies + `
+resource "oci_core_service_gateway_service_attachment" "test_service_gateway_service_attachment" {
+	#Required
+	service_gateway_id = "${oci_core_service_gateway.test_service_gateway.id}"
+	service_id = "${lookup(data.oci_core_services.test_services.services[0], "id")}"
+}
+`
+
+	// The gateway is created without services, so that they are managed by the attachments only
+	ServiceGatewayServiceAttachmentResourceDependencies = ServiceGatewayResourceDependencies + `
+resource "oci_core_service_gateway" "test_service_gateway" {
+	compartment_id = "${var.compartment_id}"
+	vcn_id = "${oci_core_vcn.test_vcn.id}"
+}
+`
+)
+
+func TestCoreServiceGatewayServiceAttachmentResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_service_gateway_service_attachment.test_service_gateway_service_attachment"
+	serviceGatewayName := "oci_core_service_gateway.test_service_gateway"
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + compartmentIdVariableStr + ServiceGatewayServiceAttachmentResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "service_gateway_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_name"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+				),
+			},
+			// verify the gateway picks up the attached service without a diff
+			{
+				Config: config + compartmentIdVariableStr + ServiceGatewayServiceAttachmentResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(serviceGatewayName, "services.#", "1"),
+				),
+			},
+			// verify resource import
+			{
+				Config:            config,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+			// verify detach
+			{
+				Config: config + compartmentIdVariableStr + ServiceGatewayServiceAttachmentResourceDependencies,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(serviceGatewayName, "services.#", "0"),
+					resource.TestCheckResourceAttr(serviceGatewayName, "state", "AVAILABLE"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitImportServiceGatewayServiceAttachment(t *testing.T) {
+	resourceSchema := ServiceGatewayServiceAttachmentResource().Schema
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	d.SetId("serviceGateways/ocid1.servicegateway.oc1..a/services/ocid1.service.oc1..b")
+	if _, err := ImportServiceGatewayServiceAttachment(d, nil); err != nil {
+		t.Fatalf("Unexpected error importing a valid ID: %v", err)
+	}
+	if d.Get("service_gateway_id").(string) != "ocid1.servicegateway.oc1..a" || d.Get("service_id").(string) != "ocid1.service.oc1..b" {
+		t.Errorf("Unexpected import of the ID, got gateway '%s' and service '%s'", d.Get("service_gateway_id"), d.Get("service_id"))
+	}
+
+	for _, id := range []string{"ocid1.servicegateway.oc1..a", "serviceGateways/ocid1.servicegateway.oc1..a/services", "gateways/a/services/b"} {
+		d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+		d.SetId(id)
+		if _, err := ImportServiceGatewayServiceAttachment(d, nil); err == nil {
+			t.Errorf("Expected an error importing '%s'", id)
+		}
+	}
+}
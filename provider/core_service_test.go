@@ -29,7 +29,7 @@ func TestCoreServiceResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_core_services.test_services"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
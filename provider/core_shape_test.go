@@ -30,7 +30,7 @@ func TestCoreShapeResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_core_shapes.test_shapes"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
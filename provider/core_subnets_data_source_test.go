@@ -49,7 +49,7 @@ func (s *DatasourceCoreSubnetTestSuite) SetupTest() {
 }
 
 func (s *DatasourceCoreSubnetTestSuite) TestAccDatasourceCoreSubnet_basic() {
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 s.Providers,
 		Steps: []resource.TestStep{
@@ -28,7 +28,7 @@ func (s *ResourceCoreVirtualNetworkTestSuite) SetupTest() {
 
 func (s *ResourceCoreVirtualNetworkTestSuite) TestAccResourceCoreVirtualNetwork_basic() {
 	var resId string
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// test create
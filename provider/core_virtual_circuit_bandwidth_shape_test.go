@@ -30,7 +30,7 @@ func TestCoreVirtualCircuitBandwidthShapeResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_core_virtual_circuit_bandwidth_shapes.test_virtual_circuit_bandwidth_shapes"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
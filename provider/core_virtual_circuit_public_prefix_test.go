@@ -36,7 +36,7 @@ func TestCoreVirtualCircuitPublicPrefixResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_core_virtual_circuit_public_prefixes.test_virtual_circuit_public_prefixes"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -4,7 +4,12 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/crud"
@@ -12,6 +17,13 @@ import (
 	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
+const (
+	publicPrefixesPollInterval = time.Minute
+	// The states of the public prefixes while waiting for them
+	publicPrefixesPending      = "PENDING"
+	publicPrefixesSettledState = "SETTLED"
+)
+
 func VirtualCircuitResource() *schema.Resource {
 	return &schema.Resource{
 		Importer: &schema.ResourceImporter{
@@ -107,19 +119,21 @@ func VirtualCircuitResource() *schema.Resource {
 				// @CODEGEN 07/2018: The service does not return publicPrefixes as part of GET or LIST operation on this resource
 				// To get or update the publicPrefixes, once has to use the VirtualCircuitPublicPrefix APIs: https://docs.cloud.oracle.com/iaas/api/#/en/iaas/20160918/VirtualCircuitPublicPrefix/
 				//Computed: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						// Required
 						"cidr_block": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 
 						// Optional
 
 						// Computed
+						"verification_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -208,6 +222,7 @@ type VirtualCircuitResourceCrud struct {
 	crud.BaseCrud
 	Client                 *oci_core.VirtualNetworkClient
 	Res                    *oci_core.VirtualCircuit
+	PublicPrefixes         []oci_core.VirtualCircuitPublicPrefix
 	DisableNotFoundRetries bool
 }
 
@@ -344,10 +359,43 @@ func (s *VirtualCircuitResourceCrud) Get() error {
 	}
 
 	s.Res = &response.VirtualCircuit
+
+	// The public prefixes are not returned with the virtual circuit
+	if s.Res.Type == oci_core.VirtualCircuitTypePublic {
+		return s.listPublicPrefixes()
+	}
+	return nil
+}
+
+func (s *VirtualCircuitResourceCrud) listPublicPrefixes() error {
+	request := oci_core.ListVirtualCircuitPublicPrefixesRequest{}
+
+	tmp := s.D.Id()
+	request.VirtualCircuitId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.ListVirtualCircuitPublicPrefixes(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.PublicPrefixes = response.Items
 	return nil
 }
 
 func (s *VirtualCircuitResourceCrud) Update() error {
+	if s.D.HasChange("public_prefixes") {
+		// The bulk operations on public prefixes only apply to public virtual circuits
+		if type_ := s.D.Get("type").(string); !strings.EqualFold(type_, string(oci_core.VirtualCircuitTypePublic)) {
+			return fmt.Errorf("'public_prefixes' can only be set on PUBLIC virtual circuits, virtual circuit %s is %s", s.D.Id(), type_)
+		}
+
+		if err := s.updatePublicPrefixes(); err != nil {
+			return err
+		}
+	}
+
 	request := oci_core.UpdateVirtualCircuitRequest{}
 
 	if bandwidthShapeName, ok := s.D.GetOkExists("bandwidth_shape_name"); ok {
@@ -403,6 +451,107 @@ func (s *VirtualCircuitResourceCrud) Update() error {
 	return nil
 }
 
+// updatePublicPrefixes adds and deletes the public prefixes that changed, rather than recreating the virtual circuit.
+// The added prefixes are verified before the deleted ones are removed, so that the circuit keeps advertising the old
+// prefixes until the new ones can replace them.
+func (s *VirtualCircuitResourceCrud) updatePublicPrefixes() error {
+	oldRaw, newRaw := s.D.GetChange("public_prefixes")
+	oldCidrBlocks := publicPrefixCidrBlocks(oldRaw.([]interface{}))
+	newCidrBlocks := publicPrefixCidrBlocks(newRaw.([]interface{}))
+
+	virtualCircuitId := s.D.Id()
+
+	added := []string{}
+	for cidrBlock := range newCidrBlocks {
+		if !oldCidrBlocks[cidrBlock] {
+			added = append(added, cidrBlock)
+		}
+	}
+	sort.Strings(added)
+
+	if len(added) > 0 {
+		request := oci_core.BulkAddVirtualCircuitPublicPrefixesRequest{}
+		request.VirtualCircuitId = &virtualCircuitId
+		for _, cidrBlock := range added {
+			tmp := cidrBlock
+			request.PublicPrefixes = append(request.PublicPrefixes, oci_core.CreateVirtualCircuitPublicPrefixDetails{CidrBlock: &tmp})
+		}
+
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+		if err := s.Client.BulkAddVirtualCircuitPublicPrefixes(context.Background(), request); err != nil {
+			return err
+		}
+
+		if err := s.waitForPublicPrefixes(added, nil); err != nil {
+			return err
+		}
+
+		failed := []string{}
+		for _, publicPrefix := range s.PublicPrefixes {
+			if publicPrefix.CidrBlock != nil && newCidrBlocks[*publicPrefix.CidrBlock] && publicPrefix.VerificationState == oci_core.VirtualCircuitPublicPrefixVerificationStateFailed {
+				failed = append(failed, *publicPrefix.CidrBlock)
+			}
+		}
+		if len(failed) > 0 {
+			sort.Strings(failed)
+			return fmt.Errorf("verification of the public prefixes %s of virtual circuit %s failed, remove them from 'public_prefixes' to delete them", strings.Join(failed, ", "), virtualCircuitId)
+		}
+	}
+
+	deleted := []string{}
+	for cidrBlock := range oldCidrBlocks {
+		if !newCidrBlocks[cidrBlock] {
+			deleted = append(deleted, cidrBlock)
+		}
+	}
+	sort.Strings(deleted)
+
+	if len(deleted) > 0 {
+		request := oci_core.BulkDeleteVirtualCircuitPublicPrefixesRequest{}
+		request.VirtualCircuitId = &virtualCircuitId
+		for _, cidrBlock := range deleted {
+			tmp := cidrBlock
+			request.PublicPrefixes = append(request.PublicPrefixes, oci_core.DeleteVirtualCircuitPublicPrefixDetails{CidrBlock: &tmp})
+		}
+
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "core")
+
+		if err := s.Client.BulkDeleteVirtualCircuitPublicPrefixes(context.Background(), request); err != nil {
+			return err
+		}
+
+		if err := s.waitForPublicPrefixes(nil, deleted); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// waitForPublicPrefixes waits until the added prefixes are verified and the deleted ones are gone. Verification can
+// take a long time, so the prefixes are listed at a fixed interval rather than with a growing backoff.
+func (s *VirtualCircuitResourceCrud) waitForPublicPrefixes(added []string, deleted []string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{publicPrefixesPending},
+		Target:  []string{publicPrefixesSettledState},
+		Refresh: func() (interface{}, string, error) {
+			if err := s.Get(); err != nil {
+				return nil, "", err
+			}
+			if publicPrefixesSettled(s.PublicPrefixes, added, deleted) {
+				return s.PublicPrefixes, publicPrefixesSettledState, nil
+			}
+			return s.PublicPrefixes, publicPrefixesPending, nil
+		},
+		Timeout:      s.D.Timeout(schema.TimeoutUpdate),
+		PollInterval: crud.WaitDuration(s.Client.HTTPClient, publicPrefixesPollInterval),
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
 func (s *VirtualCircuitResourceCrud) Delete() error {
 	request := oci_core.DeleteVirtualCircuitRequest{}
 
@@ -460,7 +609,9 @@ func (s *VirtualCircuitResourceCrud) SetData() {
 
 	s.D.Set("provider_state", s.Res.ProviderState)
 
-	if s.Res.PublicPrefixes != nil {
+	if s.PublicPrefixes != nil {
+		s.D.Set("public_prefixes", publicPrefixesToList(s.PublicPrefixes, s.D.Get("public_prefixes").([]interface{})))
+	} else if s.Res.PublicPrefixes != nil {
 		publicPrefixes := []interface{}{}
 		for _, item := range s.Res.PublicPrefixes {
 			publicPrefixes = append(publicPrefixes, CreateVirtualCircuitPublicPrefixDetailsToMap(item))
@@ -507,6 +658,84 @@ func CreateVirtualCircuitPublicPrefixDetailsToMap(obj string) map[string]interfa
 	return result
 }
 
+func publicPrefixCidrBlocks(publicPrefixes []interface{}) map[string]bool {
+	result := map[string]bool{}
+	for _, publicPrefix := range publicPrefixes {
+		if cidrBlock, ok := publicPrefix.(map[string]interface{})["cidr_block"]; ok && cidrBlock != "" {
+			result[cidrBlock.(string)] = true
+		}
+	}
+	return result
+}
+
+// publicPrefixesSettled reports whether none of the added prefixes is still being verified and all the deleted
+// prefixes are gone.
+func publicPrefixesSettled(publicPrefixes []oci_core.VirtualCircuitPublicPrefix, added []string, deleted []string) bool {
+	verificationStates := map[string]oci_core.VirtualCircuitPublicPrefixVerificationStateEnum{}
+	for _, publicPrefix := range publicPrefixes {
+		if publicPrefix.CidrBlock != nil {
+			verificationStates[*publicPrefix.CidrBlock] = publicPrefix.VerificationState
+		}
+	}
+
+	for _, cidrBlock := range deleted {
+		if _, ok := verificationStates[cidrBlock]; ok {
+			return false
+		}
+	}
+
+	for _, cidrBlock := range added {
+		if verificationState, ok := verificationStates[cidrBlock]; !ok || verificationState == oci_core.VirtualCircuitPublicPrefixVerificationStateInProgress {
+			return false
+		}
+	}
+
+	return true
+}
+
+// publicPrefixesToList keeps the public prefixes in the order they are configured, so that a list returned in a
+// different order doesn't cause a diff. Prefixes that aren't configured follow, in the order of their CIDR blocks.
+func publicPrefixesToList(publicPrefixes []oci_core.VirtualCircuitPublicPrefix, configured []interface{}) []interface{} {
+	byCidrBlock := map[string]oci_core.VirtualCircuitPublicPrefix{}
+	for _, publicPrefix := range publicPrefixes {
+		if publicPrefix.CidrBlock != nil {
+			byCidrBlock[*publicPrefix.CidrBlock] = publicPrefix
+		}
+	}
+
+	result := []interface{}{}
+	for _, raw := range configured {
+		cidrBlock, _ := raw.(map[string]interface{})["cidr_block"].(string)
+		if publicPrefix, ok := byCidrBlock[cidrBlock]; ok {
+			result = append(result, VirtualCircuitPublicPrefixToMap(publicPrefix))
+			delete(byCidrBlock, cidrBlock)
+		}
+	}
+
+	remaining := []string{}
+	for cidrBlock := range byCidrBlock {
+		remaining = append(remaining, cidrBlock)
+	}
+	sort.Strings(remaining)
+	for _, cidrBlock := range remaining {
+		result = append(result, VirtualCircuitPublicPrefixToMap(byCidrBlock[cidrBlock]))
+	}
+
+	return result
+}
+
+func VirtualCircuitPublicPrefixToMap(obj oci_core.VirtualCircuitPublicPrefix) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if obj.CidrBlock != nil {
+		result["cidr_block"] = string(*obj.CidrBlock)
+	}
+
+	result["verification_state"] = string(obj.VerificationState)
+
+	return result
+}
+
 func mapToCrossConnectMapping(raw map[string]interface{}) oci_core.CrossConnectMapping {
 	result := oci_core.CrossConnectMapping{}
 
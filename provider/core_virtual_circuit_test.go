@@ -3,13 +3,21 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
 	"testing"
 
 	"strings"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_core "github.com/oracle/oci-go-sdk/core"
 )
 
 const (
@@ -29,6 +37,27 @@ resource "oci_core_virtual_circuit" "test_virtual_circuit" {
 		cidr_block = "${var.virtual_circuit_public_prefixes_cidr_block}"
 	}
 }
+`
+
+	VirtualCircuitPublicUpdatedPrefixesResource = VirtualCircuitResourceDependencies + `
+resource "oci_core_virtual_circuit" "test_virtual_circuit" {
+	#Required
+	compartment_id = "${var.compartment_id}"
+	type = "${var.virtual_circuit_type}"
+
+ 	#Required for PUBLIC Virtual Circuit
+	cross_connect_mappings {
+		cross_connect_or_cross_connect_group_id = "${oci_core_cross_connect.test_cross_connect.cross_connect_group_id}"
+		vlan = "${var.virtual_circuit_cross_connect_mappings_vlan}"
+	}
+	customer_bgp_asn = "${var.virtual_circuit_customer_bgp_asn}"
+	public_prefixes {
+		cidr_block = "8.0.0.0/5"
+	}
+	public_prefixes {
+		cidr_block = "16.0.0.0/5"
+	}
+}
 `
 
 	VirtualCircuitRequiredOnlyResource = VirtualCircuitResourceDependencies + `
@@ -163,7 +192,7 @@ func TestCoreVirtualCircuitResource_basic(t *testing.T) {
 
 	var resId, resId2 string
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -189,6 +218,25 @@ func TestCoreVirtualCircuitResource_basic(t *testing.T) {
 					},
 				),
 			},
+			// verify in-place update of the public prefixes - PUBLIC Virtual Circuit
+			{
+				Config: config + VirtualCircuitPropertyVariables + VirtualCircuitPublicPropertyVariables + compartmentIdVariableStr + VirtualCircuitPublicUpdatedPrefixesResource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "public_prefixes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "public_prefixes.0.cidr_block", "8.0.0.0/5"),
+					resource.TestCheckResourceAttr(resourceName, "public_prefixes.0.verification_state", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "public_prefixes.1.cidr_block", "16.0.0.0/5"),
+					resource.TestCheckResourceAttr(resourceName, "public_prefixes.1.verification_state", "COMPLETED"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
 			// delete before next create
 			{
 				Config: config + compartmentIdVariableStr + VirtualCircuitResourceDependencies,
@@ -423,3 +471,158 @@ data "oci_core_virtual_circuit" "test_virtual_circuit" {
 		},
 	})
 }
+
+func TestUnitPublicPrefixesSettled(t *testing.T) {
+	publicPrefixes := []oci_core.VirtualCircuitPublicPrefix{
+		{CidrBlock: oci_common.String("8.0.0.0/5"), VerificationState: oci_core.VirtualCircuitPublicPrefixVerificationStateCompleted},
+		{CidrBlock: oci_common.String("16.0.0.0/5"), VerificationState: oci_core.VirtualCircuitPublicPrefixVerificationStateInProgress},
+		{CidrBlock: oci_common.String("24.0.0.0/5"), VerificationState: oci_core.VirtualCircuitPublicPrefixVerificationStateFailed},
+	}
+
+	tests := []struct {
+		added    []string
+		deleted  []string
+		expected bool
+	}{
+		{added: []string{"8.0.0.0/5", "24.0.0.0/5"}, expected: true},
+		{added: []string{"16.0.0.0/5"}, expected: false},
+		{added: []string{"32.0.0.0/5"}, expected: false},
+		{deleted: []string{"32.0.0.0/5"}, expected: true},
+		{deleted: []string{"8.0.0.0/5"}, expected: false},
+	}
+
+	for _, test := range tests {
+		if actual := publicPrefixesSettled(publicPrefixes, test.added, test.deleted); actual != test.expected {
+			t.Errorf("Expected %t for added %v and deleted %v, got %t", test.expected, test.added, test.deleted, actual)
+		}
+	}
+}
+
+func TestUnitPublicPrefixesToList(t *testing.T) {
+	publicPrefixes := []oci_core.VirtualCircuitPublicPrefix{
+		{CidrBlock: oci_common.String("24.0.0.0/5"), VerificationState: oci_core.VirtualCircuitPublicPrefixVerificationStateCompleted},
+		{CidrBlock: oci_common.String("0.0.0.0/5"), VerificationState: oci_core.VirtualCircuitPublicPrefixVerificationStateCompleted},
+		{CidrBlock: oci_common.String("16.0.0.0/5"), VerificationState: oci_core.VirtualCircuitPublicPrefixVerificationStateInProgress},
+		{CidrBlock: oci_common.String("8.0.0.0/5"), VerificationState: oci_core.VirtualCircuitPublicPrefixVerificationStateCompleted},
+	}
+	configured := []interface{}{
+		map[string]interface{}{"cidr_block": "16.0.0.0/5"},
+		map[string]interface{}{"cidr_block": "32.0.0.0/5"},
+		map[string]interface{}{"cidr_block": "8.0.0.0/5"},
+	}
+
+	actual := []string{}
+	for _, publicPrefix := range publicPrefixesToList(publicPrefixes, configured) {
+		actual = append(actual, publicPrefix.(map[string]interface{})["cidr_block"].(string))
+	}
+
+	expected := []string{"16.0.0.0/5", "8.0.0.0/5", "0.0.0.0/5", "24.0.0.0/5"}
+	if strings.Join(actual, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected public prefixes %v, got %v", expected, actual)
+	}
+}
+
+// newTestVirtualCircuitService returns a local service with a virtual circuit of the given type and public prefixes,
+// which are verified as soon as they are added, and records the bulk operations on the prefixes.
+func newTestVirtualCircuitService(type_ string, cidrBlocks ...string) (*httptest.Server, func() []string) {
+	var lock sync.Mutex
+	prefixes := map[string]bool{}
+	for _, cidrBlock := range cidrBlocks {
+		prefixes[cidrBlock] = true
+	}
+	operations := []string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/publicPrefixes"):
+			items := []string{}
+			for cidrBlock := range prefixes {
+				items = append(items, fmt.Sprintf(`{"cidrBlock": "%s", "verificationState": "COMPLETED"}`, cidrBlock))
+			}
+			sort.Strings(items)
+			fmt.Fprintf(w, "[%s]", strings.Join(items, ","))
+		case strings.Contains(r.URL.Path, "/actions/"):
+			var body struct {
+				PublicPrefixes []struct {
+					CidrBlock string `json:"cidrBlock"`
+				} `json:"publicPrefixes"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			for _, publicPrefix := range body.PublicPrefixes {
+				if strings.HasSuffix(r.URL.Path, "/bulkAddPublicPrefixes") {
+					operations = append(operations, "add "+publicPrefix.CidrBlock)
+					prefixes[publicPrefix.CidrBlock] = true
+				} else {
+					operations = append(operations, "delete "+publicPrefix.CidrBlock)
+					delete(prefixes, publicPrefix.CidrBlock)
+				}
+			}
+		default:
+			fmt.Fprintf(w, `{"id": "ocid1.virtualcircuit.oc1..test", "compartmentId": "ocid1.compartment.oc1..test", "type": "%s", "lifecycleState": "PROVISIONED"}`, type_)
+		}
+	}))
+
+	return server, func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		return operations
+	}
+}
+
+func TestUnitVirtualCircuitUpdatePublicPrefixes(t *testing.T) {
+	for _, testCase := range []struct {
+		type_              string
+		expectedError      string
+		expectedOperations []string
+	}{
+		// The new prefix is added before the old one is deleted, so that the circuit always has a verified prefix
+		{type_: "PUBLIC", expectedOperations: []string{"add 16.0.0.0/5", "delete 8.0.0.0/5"}},
+		{type_: "PRIVATE", expectedError: "'public_prefixes' can only be set on PUBLIC virtual circuits", expectedOperations: []string{}},
+	} {
+		server, operations := newTestVirtualCircuitService(testCase.type_, "8.0.0.0/5")
+
+		client := oci_core.VirtualNetworkClient{BaseClient: oci_common.DefaultBaseClientWithSigner(testRequestSigner{})}
+		client.Host = server.URL
+		client.UserAgent = "test"
+
+		state := &terraform.InstanceState{
+			ID: "ocid1.virtualcircuit.oc1..test",
+			Attributes: map[string]string{
+				"id":                           "ocid1.virtualcircuit.oc1..test",
+				"compartment_id":               "ocid1.compartment.oc1..test",
+				"type":                         testCase.type_,
+				"public_prefixes.#":            "1",
+				"public_prefixes.0.cidr_block": "8.0.0.0/5",
+			},
+		}
+		rawConfig, err := config.NewRawConfig(map[string]interface{}{
+			"compartment_id":  "ocid1.compartment.oc1..test",
+			"type":            testCase.type_,
+			"public_prefixes": []interface{}{map[string]interface{}{"cidr_block": "16.0.0.0/5"}},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		virtualCircuitResource := VirtualCircuitResource()
+		diff, err := virtualCircuitResource.Diff(state, terraform.NewResourceConfig(rawConfig))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		_, err = virtualCircuitResource.Apply(state, diff, &OracleClients{virtualNetworkClient: &client})
+		server.Close()
+
+		if testCase.expectedError == "" && err != nil {
+			t.Errorf("Unexpected error updating the public prefixes of a %s virtual circuit: %v", testCase.type_, err)
+		}
+		if testCase.expectedError != "" && (err == nil || !strings.Contains(err.Error(), testCase.expectedError)) {
+			t.Errorf("Expected the error '%s' updating the public prefixes of a %s virtual circuit, got %v", testCase.expectedError, testCase.type_, err)
+		}
+		if fmt.Sprint(operations()) != fmt.Sprint(testCase.expectedOperations) {
+			t.Errorf("Expected the operations %v on the public prefixes of a %s virtual circuit, got %v", testCase.expectedOperations, testCase.type_, operations())
+		}
+	}
+}
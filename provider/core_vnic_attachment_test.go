@@ -71,7 +71,7 @@ func TestCoreVnicAttachmentResource_basic(t *testing.T) {
 	resourceName := "oci_core_vnic_attachment.test_vnic_attachment"
 	datasourceName := "data.oci_core_vnic_attachments.test_vnic_attachments"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
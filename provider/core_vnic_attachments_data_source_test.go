@@ -34,7 +34,7 @@ func (s *DatasourceCoreVnicAttachmentTestSuite) SetupTest() {
 }
 
 func (s *DatasourceCoreVnicAttachmentTestSuite) TestAccDatasourceCoreVnicAttachment_basic() {
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 s.Providers,
 		Steps: []resource.TestStep{
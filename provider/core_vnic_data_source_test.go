@@ -25,7 +25,7 @@ func (s *DatasourceCoreVnicTestSuite) SetupTest() {
 }
 
 func (s *DatasourceCoreVnicTestSuite) TestAccDatasourceCoreAttachVnic_basic() {
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			{
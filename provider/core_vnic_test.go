@@ -29,7 +29,7 @@ func TestCoreVnicResource_basic(t *testing.T) {
 
 	singularDatasourceName := "data.oci_core_vnic.test_vnic"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -103,7 +103,7 @@ func (s *ResourceCoreVolumeAttachmentTestSuite) SetupTest() {
 
 func (s *ResourceCoreVolumeAttachmentTestSuite) TestResourceCoreVolumeAttachment_basic() {
 	var resId, resId2 string
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// verify create
@@ -52,7 +52,7 @@ func TestCoreVolumeAttachmentResource_basic(t *testing.T) {
 	resourceName := "oci_core_volume_attachment.test_volume_attachment"
 	datasourceName := "data.oci_core_volume_attachments.test_volume_attachments"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
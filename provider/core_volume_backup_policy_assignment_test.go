@@ -40,7 +40,7 @@ func TestCoreVolumeBackupPolicyAssignmentResource_basic(t *testing.T) {
 	resourceName := "oci_core_volume_backup_policy_assignment.test_volume_backup_policy_assignment"
 	datasourceName := "data.oci_core_volume_backup_policy_assignments.test_volume_backup_policy_assignments"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -29,7 +29,7 @@ func TestCoreVolumeBackupPolicyResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_core_volume_backup_policies.test_volume_backup_policies"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -37,7 +37,7 @@ func (s *ResourceCoreVolumeBackupTestSuite) SetupTest() {
 
 func (s *ResourceCoreVolumeBackupTestSuite) TestAccResourceCoreVolumeBackup_basic() {
 	var resId, resId2 string
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// verify create
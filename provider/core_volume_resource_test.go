@@ -32,7 +32,7 @@ func (s *ResourceCoreVolumeTestSuite) SetupTest() {
 
 func (s *ResourceCoreVolumeTestSuite) TestCreateResourceCoreVolume_basic() {
 	var resId, resId2 string
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// specify size in MBs and GBs, expect error
@@ -181,7 +181,7 @@ func (s *ResourceCoreVolumeTestSuite) TestCreateResourceCoreVolume_basic() {
 
 func (s *ResourceCoreVolumeTestSuite) TestCreateResourceCoreVolume_destructive() {
 	var resId, resId2 string
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// create volume, use default size
@@ -46,7 +46,7 @@ func (s *DatasourceCoreVolumeTestSuite) SetupTest() {
 
 func (s *DatasourceCoreVolumeTestSuite) TestAccDatasourceCoreVolume_basic() {
 	compartmentID := getCompartmentIDForLegacyTests()
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 s.Providers,
 		Steps: []resource.TestStep{
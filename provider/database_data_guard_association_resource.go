@@ -0,0 +1,504 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+
+	oci_database "github.com/oracle/oci-go-sdk/database"
+)
+
+const (
+	DataGuardAssociationCreationTypeExistingDbSystem = "ExistingDbSystem"
+)
+
+// DataGuardAssociationResource creates a standby database and switches the roles of the databases. There is no operation
+// to remove an association, so destroying the resource only removes it from the state.
+func DataGuardAssociationResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: ImportDataGuardAssociation,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			// crud.ZeroTime is a marker so a user supplied default is not overwritten. See crud.CreateDBSystemResource
+			Create: &crud.ZeroTime,
+			Update: &crud.TwoHours,
+		},
+		Create: createDataGuardAssociation,
+		Read:   readDataGuardAssociation,
+		Update: updateDataGuardAssociation,
+		Delete: deleteDataGuardAssociation,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"creation_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: crud.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					DataGuardAssociationCreationTypeExistingDbSystem,
+				}, true),
+			},
+			"database_admin_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"database_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protection_mode": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"transport_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"failover": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"peer_db_system_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"role": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: crud.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.DataGuardAssociationRolePrimary),
+					string(oci_database.DataGuardAssociationRoleStandby),
+				}, true),
+			},
+
+			// Computed
+			"apply_lag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"apply_rate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lifecycle_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_data_guard_association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_database_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_db_home_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
+	sync := &DataGuardAssociationResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	// The association is always created with the reporting database as the primary; apply any other declared role
+	// once the standby is available.
+	desiredRole, roleDeclared := d.GetOkExists("role")
+
+	if err := crud.CreateDBSystemResource(d, sync); err != nil {
+		return err
+	}
+
+	// If the transition fails, the current role stays in the state so the transition is retried on the next apply
+	if roleDeclared && !strings.EqualFold(desiredRole.(string), string(sync.Res.Role)) {
+		if err := sync.updateRole(oci_database.DataGuardAssociationRoleEnum(strings.ToUpper(desiredRole.(string))), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+		sync.SetData()
+	}
+
+	return nil
+}
+
+func readDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
+	sync := &DataGuardAssociationResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	return crud.ReadResource(sync)
+}
+
+func updateDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
+	sync := &DataGuardAssociationResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	return crud.UpdateResource(d, sync)
+}
+
+// There is no API to remove a Data Guard association, the standby database is terminated with its DB system.
+func deleteDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// ImportDataGuardAssociation expects an ID of the form databases/{databaseId}/dataGuardAssociations/{dataGuardAssociationId}
+// because the association can only be read through the database that reports it.
+func ImportDataGuardAssociation(d *schema.ResourceData, value interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 4 || parts[0] != "databases" || parts[2] != "dataGuardAssociations" {
+		return nil, fmt.Errorf("illegal import ID '%s', expected 'databases/{databaseId}/dataGuardAssociations/{dataGuardAssociationId}'", d.Id())
+	}
+
+	d.Set("database_id", parts[1])
+	d.SetId(parts[3])
+
+	// Associations can only be created on existing DB systems
+	d.Set("creation_type", DataGuardAssociationCreationTypeExistingDbSystem)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type DataGuardAssociationResourceCrud struct {
+	crud.BaseCrud
+	Client                 *oci_database.DatabaseClient
+	Res                    *oci_database.DataGuardAssociation
+	DisableNotFoundRetries bool
+}
+
+func (s *DataGuardAssociationResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *DataGuardAssociationResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_database.DataGuardAssociationLifecycleStateProvisioning),
+	}
+}
+
+func (s *DataGuardAssociationResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_database.DataGuardAssociationLifecycleStateAvailable),
+	}
+}
+
+// DBSystemShape reports the shape of the DB system hosting the standby, so creation waits as long as provisioning a
+// DB system of that shape would.
+func (s *DataGuardAssociationResourceCrud) DBSystemShape() string {
+	if s.Res == nil || s.Res.PeerDbSystemId == nil {
+		return ""
+	}
+
+	request := oci_database.GetDbSystemRequest{}
+	request.DbSystemId = s.Res.PeerDbSystemId
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.GetDbSystem(context.Background(), request)
+	if err != nil || response.Shape == nil {
+		log.Printf("[WARN] Unable to get the shape of peer DB system '%s': %v", *s.Res.PeerDbSystemId, err)
+		return ""
+	}
+
+	return *response.Shape
+}
+
+func (s *DataGuardAssociationResourceCrud) Create() error {
+	request := oci_database.CreateDataGuardAssociationRequest{}
+
+	creationType := s.D.Get("creation_type").(string)
+	if !strings.EqualFold(creationType, DataGuardAssociationCreationTypeExistingDbSystem) {
+		return fmt.Errorf("unknown creation_type '%s'", creationType)
+	}
+
+	details := oci_database.CreateDataGuardAssociationToExistingDbSystemDetails{}
+
+	if databaseAdminPassword, ok := s.D.GetOkExists("database_admin_password"); ok {
+		tmp := databaseAdminPassword.(string)
+		details.DatabaseAdminPassword = &tmp
+	}
+
+	if peerDbSystemId, ok := s.D.GetOkExists("peer_db_system_id"); ok {
+		tmp := peerDbSystemId.(string)
+		details.PeerDbSystemId = &tmp
+	}
+
+	if protectionMode, ok := s.D.GetOkExists("protection_mode"); ok {
+		details.ProtectionMode = oci_database.CreateDataGuardAssociationDetailsProtectionModeEnum(protectionMode.(string))
+	}
+
+	if transportType, ok := s.D.GetOkExists("transport_type"); ok {
+		details.TransportType = oci_database.CreateDataGuardAssociationDetailsTransportTypeEnum(transportType.(string))
+	}
+
+	request.CreateDataGuardAssociationDetails = details
+
+	if databaseId, ok := s.D.GetOkExists("database_id"); ok {
+		tmp := databaseId.(string)
+		request.DatabaseId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.CreateDataGuardAssociation(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.DataGuardAssociation
+	return nil
+}
+
+func (s *DataGuardAssociationResourceCrud) Get() error {
+	request := oci_database.GetDataGuardAssociationRequest{}
+
+	tmp := s.D.Id()
+	request.DataGuardAssociationId = &tmp
+
+	databaseId := s.D.Get("database_id").(string)
+	request.DatabaseId = &databaseId
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.GetDataGuardAssociation(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.DataGuardAssociation
+	return nil
+}
+
+func (s *DataGuardAssociationResourceCrud) Update() error {
+	if role, ok := s.D.GetOkExists("role"); ok && s.D.HasChange("role") {
+		if err := s.updateRole(oci_database.DataGuardAssociationRoleEnum(strings.ToUpper(role.(string))), s.D.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	// database_admin_password and failover are only used by creation and role transitions, so a change to them alone is
+	// recorded without calling the service.
+	return s.Get()
+}
+
+// updateRole moves the reporting database into the desired role, one role transition at a time:
+//   - PRIMARY to STANDBY switches over to the peer database.
+//   - STANDBY to PRIMARY switches over from the peer when the peer is an available primary. Otherwise it only fails
+//     over when 'failover' is set, since a failover can't be undone without reinstating the peer.
+//   - DISABLED_STANDBY is reinstated as a STANDBY first.
+func (s *DataGuardAssociationResourceCrud) updateRole(desiredRole oci_database.DataGuardAssociationRoleEnum, timeout time.Duration) error {
+	if err := s.Get(); err != nil {
+		return err
+	}
+
+	// Each transition changes the role, so at most two are needed
+	for i := 0; i < 2 && s.Res.Role != desiredRole; i++ {
+		var nextRole oci_database.DataGuardAssociationRoleEnum
+		var err error
+
+		switch s.Res.Role {
+		case oci_database.DataGuardAssociationRolePrimary:
+			nextRole = oci_database.DataGuardAssociationRoleStandby
+			err = s.switchover(s.Res.DatabaseId, s.Res.Id)
+		case oci_database.DataGuardAssociationRoleStandby:
+			nextRole = oci_database.DataGuardAssociationRolePrimary
+			peerIsAvailablePrimary, peerErr := s.peerIsAvailablePrimary()
+			switch {
+			case peerErr != nil:
+				return peerErr
+			case peerIsAvailablePrimary:
+				err = s.switchover(s.Res.PeerDatabaseId, s.Res.PeerDataGuardAssociationId)
+			case s.D.Get("failover").(bool):
+				err = s.failover()
+			default:
+				return fmt.Errorf("the peer database of Data Guard association '%s' is not an available primary, so it can't be switched over. Set 'failover' to fail over to the reporting database", s.D.Id())
+			}
+		case oci_database.DataGuardAssociationRoleDisabledStandby:
+			nextRole = oci_database.DataGuardAssociationRoleStandby
+			err = s.reinstate()
+		default:
+			return fmt.Errorf("unexpected role '%s' for Data Guard association '%s'", s.Res.Role, s.D.Id())
+		}
+
+		if err != nil {
+			return err
+		}
+
+		roleChangedFunc := func() bool {
+			return s.Res.LifecycleState == oci_database.DataGuardAssociationLifecycleStateAvailable && s.Res.Role == nextRole
+		}
+
+		if err := crud.WaitForResourceCondition(s, roleChangedFunc, timeout); err != nil {
+			return err
+		}
+	}
+
+	if s.Res.Role != desiredRole {
+		return fmt.Errorf("a %s database can't be changed to the %s role", s.Res.Role, desiredRole)
+	}
+
+	return nil
+}
+
+// peerIsAvailablePrimary reports whether a switchover can be performed from the peer database. Errors reading the
+// peer are returned rather than taken as a sign that it failed.
+func (s *DataGuardAssociationResourceCrud) peerIsAvailablePrimary() (bool, error) {
+	if s.Res.PeerDatabaseId == nil || s.Res.PeerDataGuardAssociationId == nil {
+		return false, nil
+	}
+
+	if s.Res.PeerRole != oci_database.DataGuardAssociationPeerRolePrimary {
+		return false, nil
+	}
+
+	request := oci_database.GetDatabaseRequest{}
+	request.DatabaseId = s.Res.PeerDatabaseId
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.GetDatabase(context.Background(), request)
+	if err != nil {
+		return false, fmt.Errorf("unable to get the peer database '%s' of Data Guard association '%s': %v", *s.Res.PeerDatabaseId, s.D.Id(), err)
+	}
+
+	return response.LifecycleState == oci_database.DatabaseLifecycleStateAvailable, nil
+}
+
+// switchover transitions the primary database identified by databaseId into the standby role. It has to be called
+// through the primary's side of the association.
+func (s *DataGuardAssociationResourceCrud) switchover(databaseId *string, dataGuardAssociationId *string) error {
+	request := oci_database.SwitchoverDataGuardAssociationRequest{}
+
+	request.DatabaseId = databaseId
+	request.DataGuardAssociationId = dataGuardAssociationId
+	request.DatabaseAdminPassword = s.databaseAdminPassword()
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	_, err := s.Client.SwitchoverDataGuardAssociation(context.Background(), request)
+	return err
+}
+
+func (s *DataGuardAssociationResourceCrud) failover() error {
+	request := oci_database.FailoverDataGuardAssociationRequest{}
+
+	request.DatabaseId = s.Res.DatabaseId
+	request.DataGuardAssociationId = s.Res.Id
+	request.DatabaseAdminPassword = s.databaseAdminPassword()
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	_, err := s.Client.FailoverDataGuardAssociation(context.Background(), request)
+	return err
+}
+
+func (s *DataGuardAssociationResourceCrud) reinstate() error {
+	request := oci_database.ReinstateDataGuardAssociationRequest{}
+
+	request.DatabaseId = s.Res.DatabaseId
+	request.DataGuardAssociationId = s.Res.Id
+	request.DatabaseAdminPassword = s.databaseAdminPassword()
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	_, err := s.Client.ReinstateDataGuardAssociation(context.Background(), request)
+	return err
+}
+
+func (s *DataGuardAssociationResourceCrud) databaseAdminPassword() *string {
+	tmp := s.D.Get("database_admin_password").(string)
+	return &tmp
+}
+
+func (s *DataGuardAssociationResourceCrud) SetData() {
+	if s.Res.ApplyLag != nil {
+		s.D.Set("apply_lag", *s.Res.ApplyLag)
+	}
+
+	if s.Res.ApplyRate != nil {
+		s.D.Set("apply_rate", *s.Res.ApplyRate)
+	}
+
+	if s.Res.DatabaseId != nil {
+		s.D.Set("database_id", *s.Res.DatabaseId)
+	}
+
+	if s.Res.Id != nil {
+		s.D.Set("id", *s.Res.Id)
+	}
+
+	if s.Res.LifecycleDetails != nil {
+		s.D.Set("lifecycle_details", *s.Res.LifecycleDetails)
+	}
+
+	if s.Res.PeerDataGuardAssociationId != nil {
+		s.D.Set("peer_data_guard_association_id", *s.Res.PeerDataGuardAssociationId)
+	}
+
+	if s.Res.PeerDatabaseId != nil {
+		s.D.Set("peer_database_id", *s.Res.PeerDatabaseId)
+	}
+
+	if s.Res.PeerDbHomeId != nil {
+		s.D.Set("peer_db_home_id", *s.Res.PeerDbHomeId)
+	}
+
+	if s.Res.PeerDbSystemId != nil {
+		s.D.Set("peer_db_system_id", *s.Res.PeerDbSystemId)
+	}
+
+	s.D.Set("peer_role", s.Res.PeerRole)
+
+	s.D.Set("protection_mode", s.Res.ProtectionMode)
+
+	s.D.Set("role", s.Res.Role)
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	s.D.Set("transport_type", s.Res.TransportType)
+}
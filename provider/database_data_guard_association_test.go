@@ -0,0 +1,311 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_database "github.com/oracle/oci-go-sdk/database"
+)
+
+const (
+	DataGuardAssociationResourceConfig = DataGuardAssociationResourceDependencies + `
+resource "oci_database_data_guard_association" "test_data_guard_association" {
+	#Required
+	creation_type = "ExistingDbSystem"
+	database_admin_password = "BEstrO0ng_#11"
+	database_id = "${data.oci_database_databases.db.databases.0.id}"
+	protection_mode = "MAXIMUM_PERFORMANCE"
+	transport_type = "ASYNC"
+
+	#Optional
+	peer_db_system_id = "${oci_database_db_system.test_peer_db_system.id}"
+	role = "${var.data_guard_association_role}"
+}
+`
+	DataGuardAssociationPropertyVariables = `
+variable "data_guard_association_role" { default = "PRIMARY" }
+
+`
+	DataGuardAssociationResourceDependencies = DbHomePatchResourceDependencies + `
+data "oci_database_databases" "db" {
+	compartment_id = "${var.compartment_id}"
+	db_home_id = "${data.oci_database_db_homes.t.db_homes.0.db_home_id}"
+}
+
+resource "oci_database_db_system" "test_peer_db_system" {
+	availability_domain = "${oci_core_subnet.test_subnet.availability_domain}"
+	compartment_id = "${var.compartment_id}"
+	subnet_id = "${oci_core_subnet.test_subnet.id}"
+	database_edition = "ENTERPRISE_EDITION"
+	disk_redundancy = "NORMAL"
+	shape = "BM.DenseIO1.36"
+	cpu_core_count = "2"
+	ssh_public_keys = ["ssh-rsa KKKLK3NzaC1yc2EAAAADAQABAAABAQC+UC9MFNA55NIVtKPIBCNw7++ACXhD0hx+Zyj25JfHykjz/QU3Q5FAU3DxDbVXyubgXfb/GJnrKRY8O4QDdvnZZRvQFFEOaApThAmCAM5MuFUIHdFvlqP+0W+ZQnmtDhwVe2NCfcmOrMuaPEgOKO3DOW6I/qOOdO691Xe2S9NgT9HhN0ZfFtEODVgvYulgXuCCXsJs+NUqcHAOxxFUmwkbPvYi0P0e2DT8JKeiOOC8VKUEgvVx+GKmqasm+Y6zHFW7vv3g2GstE1aRs3mttHRoC/JPM86PRyIxeWXEMzyG5wHqUu4XZpDbnWNxi6ugxnAGiL3CrIFdCgRNgHz5qS1l MustWin"]
+	domain = "${var.subnet_dns_label}.oraclevcn.com"
+	hostname = "myOracleDBPeer"
+	data_storage_size_in_gb = "256"
+	license_model = "LICENSE_INCLUDED"
+	node_count = "1"
+	display_name = "tfDbSystemPeerTest"
+	db_home {
+		db_version = "12.1.0.2"
+		display_name = "dbHome1"
+		database {
+			"admin_password" = "BEstrO0ng_#11"
+			"db_name" = "tfPeerDb"
+		}
+	}
+}
+`
+)
+
+func TestDatabaseDataGuardAssociationResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_database_data_guard_association.test_data_guard_association"
+	datasourceName := "data.oci_database_data_guard_associations.test_data_guard_associations"
+
+	var resId, resId2 string
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + DataGuardAssociationPropertyVariables + compartmentIdVariableStr + DataGuardAssociationResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "creation_type", "ExistingDbSystem"),
+					resource.TestCheckResourceAttrSet(resourceName, "database_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "peer_data_guard_association_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "peer_database_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "peer_db_home_id"),
+					TestCheckResourceAttributesEqual(resourceName, "peer_db_system_id", "oci_database_db_system.test_peer_db_system", "id"),
+					resource.TestCheckResourceAttr(resourceName, "peer_role", "STANDBY"),
+					resource.TestCheckResourceAttr(resourceName, "protection_mode", "MAXIMUM_PERFORMANCE"),
+					resource.TestCheckResourceAttr(resourceName, "role", "PRIMARY"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceName, "transport_type", "ASYNC"),
+
+					func(s *terraform.State) (err error) {
+						resId, err = fromInstanceState(s, resourceName, "id")
+						return err
+					},
+				),
+			},
+
+			// verify switchover to the standby
+			{
+				Config: config + `
+variable "data_guard_association_role" { default = "STANDBY" }
+
+                ` + compartmentIdVariableStr + DataGuardAssociationResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "peer_role", "PRIMARY"),
+					resource.TestCheckResourceAttr(resourceName, "role", "STANDBY"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+
+			// verify switchover back to the primary
+			{
+				Config: config + `
+variable "data_guard_association_role" { default = "primary" }
+
+                ` + compartmentIdVariableStr + DataGuardAssociationResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "peer_role", "STANDBY"),
+					resource.TestCheckResourceAttr(resourceName, "role", "PRIMARY"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+
+			// verify datasource
+			{
+				Config: config + DataGuardAssociationPropertyVariables + `
+data "oci_database_data_guard_associations" "test_data_guard_associations" {
+	#Required
+	database_id = "${data.oci_database_databases.db.databases.0.id}"
+
+    filter {
+    	name = "id"
+    	values = ["${oci_database_data_guard_association.test_data_guard_association.id}"]
+    }
+}
+                ` + compartmentIdVariableStr + DataGuardAssociationResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, "database_id"),
+
+					resource.TestCheckResourceAttr(datasourceName, "data_guard_associations.#", "1"),
+					resource.TestCheckResourceAttrSet(datasourceName, "data_guard_associations.0.database_id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "data_guard_associations.0.id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "data_guard_associations.0.peer_data_guard_association_id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "data_guard_associations.0.peer_database_id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "data_guard_associations.0.peer_db_system_id"),
+					resource.TestCheckResourceAttr(datasourceName, "data_guard_associations.0.peer_role", "STANDBY"),
+					resource.TestCheckResourceAttr(datasourceName, "data_guard_associations.0.protection_mode", "MAXIMUM_PERFORMANCE"),
+					resource.TestCheckResourceAttr(datasourceName, "data_guard_associations.0.role", "PRIMARY"),
+					resource.TestCheckResourceAttr(datasourceName, "data_guard_associations.0.state", "AVAILABLE"),
+					resource.TestCheckResourceAttr(datasourceName, "data_guard_associations.0.transport_type", "ASYNC"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitImportDataGuardAssociation(t *testing.T) {
+	resourceSchema := DataGuardAssociationResource().Schema
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	d.SetId("databases/ocid1.database.oc1..aaaa/dataGuardAssociations/ocid1.dgassociation.oc1..bbbb")
+
+	results, err := ImportDataGuardAssociation(d, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error importing Data Guard association: %v", err)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 imported resource, got %d", len(results))
+	}
+
+	if id := results[0].Id(); id != "ocid1.dgassociation.oc1..bbbb" {
+		t.Errorf("Expected ID 'ocid1.dgassociation.oc1..bbbb', got '%s'", id)
+	}
+
+	if databaseId := results[0].Get("database_id").(string); databaseId != "ocid1.database.oc1..aaaa" {
+		t.Errorf("Expected database_id 'ocid1.database.oc1..aaaa', got '%s'", databaseId)
+	}
+
+	for _, id := range []string{
+		"ocid1.dgassociation.oc1..bbbb",
+		"databases/ocid1.database.oc1..aaaa/ocid1.dgassociation.oc1..bbbb",
+		"dbSystems/ocid1.database.oc1..aaaa/dataGuardAssociations/ocid1.dgassociation.oc1..bbbb",
+	} {
+		d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+		d.SetId(id)
+
+		if _, err := ImportDataGuardAssociation(d, nil); err == nil {
+			t.Errorf("Expected an error importing Data Guard association with ID '%s'", id)
+		}
+	}
+}
+
+// newTestDataGuardService returns a local service with a standby database whose peer is a primary in the given state,
+// or that can't be read when the state is empty, and records the role transitions.
+func newTestDataGuardService(peerState string) (*httptest.Server, func() []string) {
+	var lock sync.Mutex
+	role := "STANDBY"
+	transitions := []string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/actions/failover"):
+			transitions = append(transitions, "failover")
+			role = "PRIMARY"
+			fmt.Fprint(w, `{}`)
+		case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/actions/switchover"):
+			transitions = append(transitions, "switchover "+r.URL.Path)
+			role = "PRIMARY"
+			fmt.Fprint(w, `{}`)
+		case strings.HasSuffix(r.URL.Path, "/dataGuardAssociations/ocid1.dgassociation.oc1..standby"):
+			peerRole := "PRIMARY"
+			if role == "PRIMARY" {
+				peerRole = "STANDBY"
+			}
+			fmt.Fprintf(w, `{"id": "ocid1.dgassociation.oc1..standby", "databaseId": "ocid1.database.oc1..standby", "role": "%s", "lifecycleState": "AVAILABLE",
+				"peerDatabaseId": "ocid1.database.oc1..primary", "peerDataGuardAssociationId": "ocid1.dgassociation.oc1..primary", "peerRole": "%s"}`, role, peerRole)
+		case r.URL.Path == "/databases/ocid1.database.oc1..primary" && peerState != "":
+			fmt.Fprintf(w, `{"id": "ocid1.database.oc1..primary", "lifecycleState": "%s"}`, peerState)
+		default:
+			w.WriteHeader(404)
+			fmt.Fprint(w, `{"code": "NotAuthorizedOrNotFound", "message": "test"}`)
+		}
+	}))
+
+	return server, func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		return transitions
+	}
+}
+
+func TestUnitDataGuardAssociationUpdateRole(t *testing.T) {
+	for _, testCase := range []struct {
+		peerState           string
+		failover            bool
+		expectedError       string
+		expectedTransitions []string
+	}{
+		{peerState: "AVAILABLE", expectedTransitions: []string{"switchover /databases/ocid1.database.oc1..primary/dataGuardAssociations/ocid1.dgassociation.oc1..primary/actions/switchover"}},
+		{peerState: "AVAILABLE", failover: true, expectedTransitions: []string{"switchover /databases/ocid1.database.oc1..primary/dataGuardAssociations/ocid1.dgassociation.oc1..primary/actions/switchover"}},
+		{peerState: "FAILED", expectedError: "Set 'failover'", expectedTransitions: []string{}},
+		{peerState: "FAILED", failover: true, expectedTransitions: []string{"failover"}},
+		// The peer can't be read, which doesn't mean that it failed
+		{peerState: "", failover: true, expectedError: "unable to get the peer database", expectedTransitions: []string{}},
+	} {
+		server, transitions := newTestDataGuardService(testCase.peerState)
+
+		client := oci_database.DatabaseClient{BaseClient: oci_common.DefaultBaseClientWithSigner(testRequestSigner{})}
+		client.Host = server.URL
+		client.UserAgent = "test"
+
+		d := schema.TestResourceDataRaw(t, DataGuardAssociationResource().Schema, map[string]interface{}{
+			"database_id":             "ocid1.database.oc1..standby",
+			"database_admin_password": "BEstrO0ng_#11",
+			"failover":                testCase.failover,
+		})
+		d.SetId("ocid1.dgassociation.oc1..standby")
+		sync := &DataGuardAssociationResourceCrud{Client: &client, DisableNotFoundRetries: true}
+		sync.D = d
+
+		err := sync.updateRole(oci_database.DataGuardAssociationRolePrimary, time.Minute)
+		server.Close()
+
+		if testCase.expectedError == "" && err != nil {
+			t.Errorf("Unexpected error with the peer %s and failover %t: %v", testCase.peerState, testCase.failover, err)
+		}
+		if testCase.expectedError != "" && (err == nil || !strings.Contains(err.Error(), testCase.expectedError)) {
+			t.Errorf("Expected the error '%s' with the peer %s and failover %t, got %v", testCase.expectedError, testCase.peerState, testCase.failover, err)
+		}
+		if fmt.Sprint(transitions()) != fmt.Sprint(testCase.expectedTransitions) {
+			t.Errorf("Expected the transitions %v with the peer %s and failover %t, got %v", testCase.expectedTransitions, testCase.peerState, testCase.failover, transitions())
+		}
+	}
+}
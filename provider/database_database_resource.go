@@ -0,0 +1,339 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+
+	oci_database "github.com/oracle/oci-go-sdk/database"
+)
+
+// DatabaseResource manages the backup configuration and tags of an existing database, which is created together with
+// its DB system or DB home.
+func DatabaseResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: ImportDatabase,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: &crud.TwoHours,
+			Update: &crud.TwoHours,
+		},
+		Create: createDatabase,
+		Read:   readDatabaseResource,
+		Update: updateDatabase,
+		Delete: deleteDatabase,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"database_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"db_backup_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+						"auto_backup_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						// Computed
+					},
+				},
+			},
+			"defined_tags": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: definedTagsDiffSuppressFunction,
+				Elem:             schema.TypeString,
+			},
+			"freeform_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
+
+			// Computed
+			"character_set": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_home_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_unique_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_workload": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lifecycle_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ncharacter_set": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pdb_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createDatabase(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	return crud.CreateResource(d, sync)
+}
+
+func readDatabaseResource(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	return crud.ReadResource(sync)
+}
+
+func updateDatabase(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	return crud.UpdateResource(d, sync)
+}
+
+func deleteDatabase(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+func ImportDatabase(d *schema.ResourceData, value interface{}) ([]*schema.ResourceData, error) {
+	err := d.Set("database_id", d.Id())
+	return []*schema.ResourceData{d}, err
+}
+
+type DatabaseResourceCrud struct {
+	crud.BaseCrud
+	Client                 *oci_database.DatabaseClient
+	Res                    *oci_database.Database
+	DisableNotFoundRetries bool
+}
+
+func (s *DatabaseResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *DatabaseResourceCrud) CreatedPending() []string {
+	return s.UpdatedPending()
+}
+
+func (s *DatabaseResourceCrud) CreatedTarget() []string {
+	return s.UpdatedTarget()
+}
+
+func (s *DatabaseResourceCrud) UpdatedPending() []string {
+	return []string{
+		string(oci_database.DatabaseLifecycleStateProvisioning),
+		string(oci_database.DatabaseLifecycleStateUpdating),
+		string(oci_database.DatabaseLifecycleStateBackupInProgress),
+	}
+}
+
+func (s *DatabaseResourceCrud) UpdatedTarget() []string {
+	return []string{
+		string(oci_database.DatabaseLifecycleStateAvailable),
+	}
+}
+
+func (s *DatabaseResourceCrud) DeletedPending() []string {
+	return []string{
+		string(oci_database.DatabaseLifecycleStateTerminating),
+	}
+}
+
+func (s *DatabaseResourceCrud) DeletedTarget() []string {
+	return []string{
+		string(oci_database.DatabaseLifecycleStateTerminated),
+	}
+}
+
+func (s *DatabaseResourceCrud) Create() error {
+	// The database already exists. Just set the ID and apply the configuration to it.
+	s.D.SetId(s.D.Get("database_id").(string))
+
+	if err := s.Get(); err != nil {
+		return err
+	}
+
+	return s.Update()
+}
+
+func (s *DatabaseResourceCrud) Get() error {
+	request := oci_database.GetDatabaseRequest{}
+
+	tmp := s.D.Id()
+	request.DatabaseId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.GetDatabase(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Database
+	return nil
+}
+
+func (s *DatabaseResourceCrud) Update() error {
+	if s.D.HasChange("db_backup_config") || s.D.HasChange("defined_tags") || s.D.HasChange("freeform_tags") {
+		if err := s.updateDatabase(); err != nil {
+			return err
+		}
+		s.D.SetPartial("db_backup_config")
+		s.D.SetPartial("defined_tags")
+		s.D.SetPartial("freeform_tags")
+	}
+
+	if s.Res == nil {
+		return s.Get()
+	}
+
+	return nil
+}
+
+func (s *DatabaseResourceCrud) updateDatabase() error {
+	request := oci_database.UpdateDatabaseRequest{}
+
+	tmp := s.D.Id()
+	request.DatabaseId = &tmp
+
+	if dbBackupConfig, ok := s.D.GetOkExists("db_backup_config"); ok {
+		if tmpList := dbBackupConfig.([]interface{}); len(tmpList) > 0 && tmpList[0] != nil {
+			tmp := mapToDbBackupConfig(tmpList[0].(map[string]interface{}))
+			request.DbBackupConfig = &tmp
+		}
+	}
+
+	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
+		convertedDefinedTags, err := mapToDefinedTags(definedTags.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		request.DefinedTags = convertedDefinedTags
+	}
+
+	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok {
+		request.FreeformTags = objectMapToStringMap(freeformTags.(map[string]interface{}))
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.UpdateDatabase(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Database
+	return nil
+}
+
+func (s *DatabaseResourceCrud) SetData() {
+	if s.Res.CharacterSet != nil {
+		s.D.Set("character_set", *s.Res.CharacterSet)
+	}
+
+	if s.Res.CompartmentId != nil {
+		s.D.Set("compartment_id", *s.Res.CompartmentId)
+	}
+
+	if s.Res.DbBackupConfig != nil {
+		s.D.Set("db_backup_config", []interface{}{dbBackupConfigToMap(s.Res.DbBackupConfig)})
+	}
+
+	if s.Res.DbHomeId != nil {
+		s.D.Set("db_home_id", *s.Res.DbHomeId)
+	}
+
+	if s.Res.DbName != nil {
+		s.D.Set("db_name", *s.Res.DbName)
+	}
+
+	if s.Res.DbUniqueName != nil {
+		s.D.Set("db_unique_name", *s.Res.DbUniqueName)
+	}
+
+	if s.Res.DbWorkload != nil {
+		s.D.Set("db_workload", *s.Res.DbWorkload)
+	}
+
+	if s.Res.DefinedTags != nil {
+		s.D.Set("defined_tags", definedTagsToMap(s.Res.DefinedTags))
+	}
+
+	s.D.Set("freeform_tags", s.Res.FreeformTags)
+
+	if s.Res.Id != nil {
+		s.D.Set("database_id", *s.Res.Id)
+	}
+
+	if s.Res.LifecycleDetails != nil {
+		s.D.Set("lifecycle_details", *s.Res.LifecycleDetails)
+	}
+
+	if s.Res.NcharacterSet != nil {
+		s.D.Set("ncharacter_set", *s.Res.NcharacterSet)
+	}
+
+	if s.Res.PdbName != nil {
+		s.D.Set("pdb_name", *s.Res.PdbName)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const (
+	DatabaseRequiredOnlyResource = DatabaseResourceDependencies + `
+resource "oci_database_database" "test_database" {
+	#Required
+	database_id = "${data.oci_database_databases.db.databases.0.id}"
+}
+`
+
+	DatabaseResourceConfig = DatabaseResourceDependencies + `
+resource "oci_database_database" "test_database" {
+	#Required
+	database_id = "${data.oci_database_databases.db.databases.0.id}"
+
+	#Optional
+	db_backup_config {
+		auto_backup_enabled = "${var.database_db_backup_config_auto_backup_enabled}"
+	}
+	defined_tags = "${map("${oci_identity_tag_namespace.tag-namespace1.name}.${oci_identity_tag.tag1.name}", "${var.database_defined_tags_value}")}"
+	freeform_tags = "${var.database_freeform_tags}"
+}
+`
+	DatabasePropertyVariables = `
+variable "database_db_backup_config_auto_backup_enabled" { default = false }
+variable "database_defined_tags_value" { default = "value" }
+variable "database_freeform_tags" { default = {"Department"= "Finance"} }
+
+`
+	DatabaseResourceDependencies = DefinedTagsDependencies + DbHomePatchResourceDependencies + `
+data "oci_database_databases" "db" {
+	compartment_id = "${var.compartment_id}"
+	db_home_id = "${data.oci_database_db_homes.t.db_homes.0.db_home_id}"
+}
+`
+)
+
+func TestDatabaseDatabaseResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_database_database.test_database"
+
+	var resId, resId2 string
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + DatabasePropertyVariables + compartmentIdVariableStr + DatabaseRequiredOnlyResource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "database_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+				),
+			},
+
+			// delete before next create
+			{
+				Config: config + compartmentIdVariableStr + DatabaseResourceDependencies,
+			},
+			// verify create with optionals
+			{
+				Config: config + DatabasePropertyVariables + compartmentIdVariableStr + DatabaseResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "character_set"),
+					resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttrSet(resourceName, "database_id"),
+					resource.TestCheckResourceAttr(resourceName, "db_backup_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "db_backup_config.0.auto_backup_enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "db_home_id"),
+					resource.TestCheckResourceAttr(resourceName, "db_name", "tfDbName"),
+					resource.TestCheckResourceAttr(resourceName, "defined_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet(resourceName, "time_created"),
+
+					func(s *terraform.State) (err error) {
+						resId, err = fromInstanceState(s, resourceName, "id")
+						return err
+					},
+				),
+			},
+
+			// verify updates to updatable parameters
+			{
+				Config: config + `
+variable "database_db_backup_config_auto_backup_enabled" { default = true }
+variable "database_defined_tags_value" { default = "updatedValue" }
+variable "database_freeform_tags" { default = {"Department"= "Accounting"} }
+
+                ` + compartmentIdVariableStr + DatabaseResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttrSet(resourceName, "database_id"),
+					resource.TestCheckResourceAttr(resourceName, "db_backup_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "db_backup_config.0.auto_backup_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "defined_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+
+			// verify resource import
+			{
+				Config: config + `
+variable "database_db_backup_config_auto_backup_enabled" { default = true }
+variable "database_defined_tags_value" { default = "updatedValue" }
+variable "database_freeform_tags" { default = {"Department"= "Accounting"} }
+
+                ` + compartmentIdVariableStr + DatabaseResourceConfig,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
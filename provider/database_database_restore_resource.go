@@ -0,0 +1,222 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_database "github.com/oracle/oci-go-sdk/database"
+)
+
+const (
+	// A restore can take the database from AVAILABLE back to AVAILABLE between two polls, so it is polled often, and
+	// assumed to have been restored if it isn't seen to leave AVAILABLE within the settle window
+	databaseRestorePollInterval = 5 * time.Second
+	databaseRestoreSettleWindow = 5 * time.Minute
+)
+
+// DatabaseRestoreResource restores an existing database once, when it is created. Every argument forces a new
+// resource, so a restore only runs again when the restore point changes or the resource is tainted.
+func DatabaseRestoreResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: &crud.TwoHours,
+		},
+		Create: createDatabaseRestore,
+		Read:   readDatabaseRestore,
+		Delete: deleteDatabaseRestore,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"database_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"database_scn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"latest": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"timestamp": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"lifecycle_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createDatabaseRestore(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseRestoreResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	return crud.CreateResource(d, sync)
+}
+
+func readDatabaseRestore(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseRestoreResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	return crud.ReadResource(sync)
+}
+
+func deleteDatabaseRestore(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+type DatabaseRestoreResourceCrud struct {
+	crud.BaseCrud
+	Client                 *oci_database.DatabaseClient
+	Res                    *oci_database.Database
+	DisableNotFoundRetries bool
+}
+
+func (s *DatabaseRestoreResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *DatabaseRestoreResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_database.DatabaseLifecycleStateUpdating),
+		string(oci_database.DatabaseLifecycleStateBackupInProgress),
+	}
+}
+
+func (s *DatabaseRestoreResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_database.DatabaseLifecycleStateAvailable),
+	}
+}
+
+func (s *DatabaseRestoreResourceCrud) Create() error {
+	details, err := mapToRestoreDatabaseDetails(map[string]interface{}{
+		"database_scn": s.D.Get("database_scn"),
+		"latest":       s.D.Get("latest"),
+		"timestamp":    s.D.Get("timestamp"),
+	})
+	if err != nil {
+		return err
+	}
+
+	// The database can't be restored while another operation is in progress
+	if err := s.Get(); err != nil {
+		return err
+	}
+
+	if s.Res.LifecycleState != oci_database.DatabaseLifecycleStateAvailable {
+		databaseAvailableFunc := func() bool {
+			return s.Res.LifecycleState == oci_database.DatabaseLifecycleStateAvailable
+		}
+
+		if err := crud.WaitForResourceCondition(s, databaseAvailableFunc, s.D.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	request := oci_database.RestoreDatabaseRequest{}
+
+	tmp := s.D.Get("database_id").(string)
+	request.DatabaseId = &tmp
+
+	request.RestoreDatabaseDetails = details
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.RestoreDatabase(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Database
+
+	// The database can still be AVAILABLE when the restore is accepted, which is also the target state. Wait for the
+	// restore to start, so that waiting for the target state doesn't return before the database is restored.
+	restoreStartedFunc := func() bool { return s.Res.LifecycleState != oci_database.DatabaseLifecycleStateAvailable }
+	return crud.WaitForActionStarted(s, restoreStartedFunc, databaseRestorePollInterval, databaseRestoreSettleWindow)
+}
+
+func (s *DatabaseRestoreResourceCrud) Get() error {
+	request := oci_database.GetDatabaseRequest{}
+
+	tmp := s.D.Get("database_id").(string)
+	request.DatabaseId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.GetDatabase(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Database
+	return nil
+}
+
+func (s *DatabaseRestoreResourceCrud) SetData() {
+	if s.Res.LifecycleDetails != nil {
+		s.D.Set("lifecycle_details", *s.Res.LifecycleDetails)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+}
+
+// mapToRestoreDatabaseDetails requires exactly one restore point, so a restore is never silently performed to a
+// different point than the one intended.
+func mapToRestoreDatabaseDetails(raw map[string]interface{}) (oci_database.RestoreDatabaseDetails, error) {
+	result := oci_database.RestoreDatabaseDetails{}
+	restorePoints := 0
+
+	if databaseScn, ok := raw["database_scn"]; ok && databaseScn != "" {
+		tmp := databaseScn.(string)
+		result.DatabaseSCN = &tmp
+		restorePoints++
+	}
+
+	if latest, ok := raw["latest"]; ok && latest.(bool) {
+		tmp := true
+		result.Latest = &tmp
+		restorePoints++
+	}
+
+	if timestamp, ok := raw["timestamp"]; ok && timestamp != "" {
+		tmp, err := time.Parse(time.RFC3339, timestamp.(string))
+		if err != nil {
+			return result, err
+		}
+		result.Timestamp = &oci_common.SDKTime{Time: tmp}
+		restorePoints++
+	}
+
+	if restorePoints != 1 {
+		return result, fmt.Errorf("exactly one of database_scn, latest or timestamp must be set")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_database "github.com/oracle/oci-go-sdk/database"
+)
+
+const (
+	DatabaseRestoreResourceConfig = DatabaseResourceDependencies + `
+resource "oci_database_backup" "test_backup" {
+	database_id = "${data.oci_database_databases.db.databases.0.id}"
+	display_name = "Restore Backup"
+}
+
+resource "oci_database_database_restore" "test_database_restore" {
+	# A backup is needed to restore from
+	depends_on = ["oci_database_backup.test_backup"]
+
+	#Required
+	database_id = "${data.oci_database_databases.db.databases.0.id}"
+
+	#Optional
+	latest = true
+}
+`
+)
+
+func TestDatabaseDatabaseRestoreResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_database_database_restore.test_database_restore"
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify restore to the latest backup
+			{
+				Config: config + compartmentIdVariableStr + DatabaseRestoreResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "database_id"),
+					resource.TestCheckResourceAttr(resourceName, "latest", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDatabaseRestoreWaitsForRestore(t *testing.T) {
+	states := []string{"AVAILABLE", "AVAILABLE", "AVAILABLE", "UPDATING", "UPDATING", "AVAILABLE"}
+	var requests, restores int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			atomic.AddInt32(&restores, 1)
+		}
+		count := int(atomic.AddInt32(&requests, 1))
+		if count > len(states) {
+			count = len(states)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id": "ocid1.database.oc1..test", "lifecycleState": "%s"}`, states[count-1])
+	}))
+	defer server.Close()
+
+	client := oci_database.DatabaseClient{BaseClient: oci_common.DefaultBaseClientWithSigner(testRequestSigner{})}
+	client.Host = server.URL
+	client.UserAgent = "test"
+
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"database_id": "ocid1.database.oc1..test",
+		"latest":      true,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	restoreResource := DatabaseRestoreResource()
+	diff, err := restoreResource.Diff(nil, terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	state, err := restoreResource.Apply(nil, diff, &OracleClients{databaseClient: &client})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if atomic.LoadInt32(&restores) != 1 {
+		t.Errorf("Expected the database to be restored once, got %d restores", restores)
+	}
+	if int(atomic.LoadInt32(&requests)) != len(states) {
+		t.Errorf("Expected the database to be polled until it is AVAILABLE again, got %d requests", requests)
+	}
+	if state.Attributes["state"] != "AVAILABLE" {
+		t.Errorf("Expected the state AVAILABLE, got %s", state.Attributes["state"])
+	}
+}
+
+func TestUnitMapToRestoreDatabaseDetails(t *testing.T) {
+	details, err := mapToRestoreDatabaseDetails(map[string]interface{}{"database_scn": "", "latest": false, "timestamp": "2018-07-01T10:00:00Z"})
+	if err != nil {
+		t.Fatalf("Unexpected error mapping restore with a timestamp: %v", err)
+	}
+
+	if details.Timestamp == nil || !details.Timestamp.Equal(time.Date(2018, 7, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected timestamp 2018-07-01T10:00:00Z, got %v", details.Timestamp)
+	}
+
+	if details.Latest != nil || details.DatabaseSCN != nil {
+		t.Errorf("Expected only the timestamp to be set, got %v", details)
+	}
+
+	details, err = mapToRestoreDatabaseDetails(map[string]interface{}{"database_scn": "1234", "latest": false, "timestamp": ""})
+	if err != nil {
+		t.Fatalf("Unexpected error mapping restore with an SCN: %v", err)
+	}
+
+	if details.DatabaseSCN == nil || *details.DatabaseSCN != "1234" {
+		t.Errorf("Expected SCN 1234, got %v", details.DatabaseSCN)
+	}
+
+	details, err = mapToRestoreDatabaseDetails(map[string]interface{}{"database_scn": "", "latest": true, "timestamp": ""})
+	if err != nil {
+		t.Fatalf("Unexpected error mapping restore to the latest backup: %v", err)
+	}
+
+	if details.Latest == nil || !*details.Latest {
+		t.Errorf("Expected latest to be set, got %v", details.Latest)
+	}
+
+	for _, raw := range []map[string]interface{}{
+		{"database_scn": "", "latest": false, "timestamp": ""},
+		{"database_scn": "1234", "latest": true, "timestamp": ""},
+		{"database_scn": "", "latest": true, "timestamp": "2018-07-01T10:00:00Z"},
+		{"database_scn": "", "latest": false, "timestamp": "yesterday"},
+	} {
+		if _, err := mapToRestoreDatabaseDetails(raw); err == nil {
+			t.Errorf("Expected an error mapping restore %v", raw)
+		}
+	}
+}
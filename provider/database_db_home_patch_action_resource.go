@@ -0,0 +1,257 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+
+	oci_database "github.com/oracle/oci-go-sdk/database"
+)
+
+// DbHomePatchActionResource applies, or prechecks, a patch on an existing DB home. Destroying the resource only
+// removes it from the state; it doesn't roll back the patch.
+func DbHomePatchActionResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: &crud.TwoHours,
+		},
+		Create: createDbHomePatchAction,
+		Read:   readDbHomePatchAction,
+		Delete: deleteDbHomePatchAction,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: crud.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.PatchDetailsActionApply),
+					string(oci_database.PatchDetailsActionPrecheck),
+				}, true),
+			},
+			"db_home_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"patch_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"db_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lifecycle_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"patch_history_entry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_ended": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_started": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createDbHomePatchAction(d *schema.ResourceData, m interface{}) error {
+	sync := &DbHomePatchActionResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	return crud.CreateResource(d, sync)
+}
+
+func readDbHomePatchAction(d *schema.ResourceData, m interface{}) error {
+	sync := &DbHomePatchActionResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	return crud.ReadResource(sync)
+}
+
+func deleteDbHomePatchAction(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+type DbHomePatchActionResourceCrud struct {
+	crud.BaseCrud
+	Client                 *oci_database.DatabaseClient
+	DbHome                 *oci_database.DbHome
+	Res                    *oci_database.PatchHistoryEntry
+	DisableNotFoundRetries bool
+}
+
+func (s *DbHomePatchActionResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *DbHomePatchActionResourceCrud) Create() error {
+	// Remember the last patch history entry, so the entry for this patch can be told apart from it
+	if err := s.getDbHome(); err != nil {
+		return err
+	}
+	previousPatchHistoryEntryId := s.DbHome.LastPatchHistoryEntryId
+
+	request := oci_database.UpdateDbHomeRequest{}
+
+	dbHomeId := s.D.Get("db_home_id").(string)
+	request.DbHomeId = &dbHomeId
+
+	patchId := s.D.Get("patch_id").(string)
+	request.DbVersion = &oci_database.PatchDetails{
+		Action:  oci_database.PatchDetailsActionEnum(strings.ToUpper(s.D.Get("action").(string))),
+		PatchId: &patchId,
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.UpdateDbHome(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.DbHome = &response.DbHome
+
+	patchFinishedFunc := func() bool {
+		if s.Res == nil || s.DbHome.LastPatchHistoryEntryId == nil {
+			return false
+		}
+
+		if previousPatchHistoryEntryId != nil && *s.DbHome.LastPatchHistoryEntryId == *previousPatchHistoryEntryId {
+			return false
+		}
+
+		return s.DbHome.LifecycleState == oci_database.DbHomeLifecycleStateAvailable &&
+			s.Res.LifecycleState != oci_database.PatchHistoryEntryLifecycleStateInProgress
+	}
+
+	if err := crud.WaitForResourceCondition(s, patchFinishedFunc, s.D.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	if s.Res.LifecycleState == oci_database.PatchHistoryEntryLifecycleStateFailed {
+		details := ""
+		if s.Res.LifecycleDetails != nil {
+			details = *s.Res.LifecycleDetails
+		}
+		return fmt.Errorf("%s of patch '%s' on DB home '%s' failed: %s", s.Res.Action, patchId, dbHomeId, details)
+	}
+
+	return nil
+}
+
+// Get reads the patch history entry recorded for this action. Until it is known, the DB home's most recent entry is
+// used instead, as long as it is for the patch of this action.
+func (s *DbHomePatchActionResourceCrud) Get() error {
+	if err := s.getDbHome(); err != nil {
+		return err
+	}
+
+	patchId := s.D.Get("patch_id").(string)
+	patchHistoryEntryId := s.D.Get("patch_history_entry_id").(string)
+	recorded := patchHistoryEntryId != ""
+	if !recorded {
+		if s.DbHome.LastPatchHistoryEntryId == nil {
+			return nil
+		}
+		patchHistoryEntryId = *s.DbHome.LastPatchHistoryEntryId
+	}
+
+	request := oci_database.GetDbHomePatchHistoryEntryRequest{}
+
+	request.DbHomeId = s.DbHome.Id
+	request.PatchHistoryEntryId = &patchHistoryEntryId
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.GetDbHomePatchHistoryEntry(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	if response.PatchId == nil || *response.PatchId != patchId {
+		if recorded {
+			return fmt.Errorf("patch history entry '%s' of DB home '%s' is not for patch '%s'", patchHistoryEntryId, *s.DbHome.Id, patchId)
+		}
+		// The entry of another patch, the entry of this action isn't recorded yet
+		s.Res = nil
+		return nil
+	}
+
+	s.Res = &response.PatchHistoryEntry
+	return nil
+}
+
+func (s *DbHomePatchActionResourceCrud) getDbHome() error {
+	request := oci_database.GetDbHomeRequest{}
+
+	tmp := s.D.Get("db_home_id").(string)
+	request.DbHomeId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.GetDbHome(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.DbHome = &response.DbHome
+	return nil
+}
+
+func (s *DbHomePatchActionResourceCrud) SetData() {
+	if s.DbHome != nil && s.DbHome.DbVersion != nil {
+		s.D.Set("db_version", *s.DbHome.DbVersion)
+	}
+
+	if s.Res == nil {
+		return
+	}
+
+	if s.Res.Id != nil {
+		s.D.Set("patch_history_entry_id", *s.Res.Id)
+	}
+
+	if s.Res.LifecycleDetails != nil {
+		s.D.Set("lifecycle_details", *s.Res.LifecycleDetails)
+	}
+
+	if s.Res.PatchId != nil {
+		s.D.Set("patch_id", *s.Res.PatchId)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeEnded != nil {
+		s.D.Set("time_ended", s.Res.TimeEnded.String())
+	}
+
+	if s.Res.TimeStarted != nil {
+		s.D.Set("time_started", s.Res.TimeStarted.String())
+	}
+}
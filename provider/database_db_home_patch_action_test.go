@@ -0,0 +1,128 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_database "github.com/oracle/oci-go-sdk/database"
+)
+
+const (
+	DbHomePatchActionResourceConfig = DbHomePatchActionResourceDependencies + `
+resource "oci_database_db_home_patch_action" "test_db_home_patch_action" {
+	#Required
+	action = "${var.db_home_patch_action_action}"
+	db_home_id = "${data.oci_database_db_homes.t.db_homes.0.db_home_id}"
+	patch_id = "${data.oci_database_db_home_patches.t.patches.0.id}"
+}
+`
+	DbHomePatchActionResourceDependencies = DbHomePatchResourceDependencies + `
+data "oci_database_db_home_patches" "t" {
+	db_home_id = "${data.oci_database_db_homes.t.db_homes.0.db_home_id}"
+}
+`
+)
+
+func TestDatabaseDbHomePatchActionResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_database_db_home_patch_action.test_db_home_patch_action"
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify precheck
+			{
+				Config: config + `
+variable "db_home_patch_action_action" { default = "PRECHECK" }
+
+                ` + compartmentIdVariableStr + DbHomePatchActionResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "PRECHECK"),
+					resource.TestCheckResourceAttrSet(resourceName, "db_home_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "db_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "patch_history_entry_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "patch_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "SUCCEEDED"),
+					resource.TestCheckResourceAttrSet(resourceName, "time_ended"),
+					resource.TestCheckResourceAttrSet(resourceName, "time_started"),
+				),
+			},
+			// verify apply
+			{
+				Config: config + `
+variable "db_home_patch_action_action" { default = "apply" }
+
+                ` + compartmentIdVariableStr + DbHomePatchActionResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "apply"),
+					resource.TestCheckResourceAttrSet(resourceName, "patch_history_entry_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "SUCCEEDED"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDbHomePatchActionGetMatchesPatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/dbHomes/ocid1.dbhome.oc1..test":
+			fmt.Fprint(w, `{"id": "ocid1.dbhome.oc1..test", "lifecycleState": "AVAILABLE", "lastPatchHistoryEntryId": "ocid1.entry.oc1..other"}`)
+		case "/dbHomes/ocid1.dbhome.oc1..test/patchHistoryEntries/ocid1.entry.oc1..other":
+			fmt.Fprint(w, `{"id": "ocid1.entry.oc1..other", "patchId": "ocid1.patch.oc1..other", "lifecycleState": "SUCCEEDED"}`)
+		default:
+			w.WriteHeader(404)
+			fmt.Fprint(w, `{"code": "NotAuthorizedOrNotFound", "message": "test"}`)
+		}
+	}))
+	defer server.Close()
+
+	client := oci_database.DatabaseClient{BaseClient: oci_common.DefaultBaseClientWithSigner(testRequestSigner{})}
+	client.Host = server.URL
+	client.UserAgent = "test"
+
+	newSync := func(patchHistoryEntryId string) *DbHomePatchActionResourceCrud {
+		d := schema.TestResourceDataRaw(t, DbHomePatchActionResource().Schema, map[string]interface{}{
+			"action":                 "APPLY",
+			"db_home_id":             "ocid1.dbhome.oc1..test",
+			"patch_id":               "ocid1.patch.oc1..test",
+			"patch_history_entry_id": patchHistoryEntryId,
+		})
+		sync := &DbHomePatchActionResourceCrud{Client: &client, DisableNotFoundRetries: true}
+		sync.D = d
+		return sync
+	}
+
+	// The last entry is for another patch, so the entry of this action isn't known yet
+	sync := newSync("")
+	if err := sync.Get(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sync.SetData()
+	if sync.Res != nil || sync.D.Get("patch_id") != "ocid1.patch.oc1..test" || sync.D.Get("patch_history_entry_id") != "" {
+		t.Errorf("Expected the entry of another patch to be ignored, got %v, %v", sync.Res, sync.D.State())
+	}
+
+	// The recorded entry must be for the patch of this action
+	sync = newSync("ocid1.entry.oc1..other")
+	if err := sync.Get(); err == nil || !strings.Contains(err.Error(), "is not for patch 'ocid1.patch.oc1..test'") {
+		t.Errorf("Expected an error for the entry of another patch, got %v", err)
+	}
+}
@@ -23,7 +23,7 @@ func TestDatabaseDbHomePatchHistoryEntryResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_database_db_home_patch_history_entries.test_db_home_patch_history_entries"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -59,7 +59,7 @@ func TestDatabaseDbHomePatchResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_database_db_home_patches.test_db_home_patches"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -84,7 +84,7 @@ func TestDatabaseDbHomeResource_basic(t *testing.T) {
 	resourceName := "oci_database_db_home.test_db_home"
 	datasourceName := "data.oci_database_db_homes.test_db_homes"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
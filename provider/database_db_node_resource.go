@@ -0,0 +1,241 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+
+	oci_database "github.com/oracle/oci-go-sdk/database"
+)
+
+// DbNodeResource manages the power state of an existing DB node, which is created together with its DB system.
+// Destroying the resource only removes it from the state; it doesn't change the node.
+func DbNodeResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: ImportDbNode,
+		},
+		Timeouts: crud.DefaultTimeout,
+		Create:   createDbNode,
+		Read:     readDbNodeResource,
+		Update:   updateDbNode,
+		Delete:   deleteDbNode,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"db_node_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: crud.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.DbNodeLifecycleStateAvailable),
+					string(oci_database.DbNodeLifecycleStateStopped),
+				}, true),
+			},
+
+			// Computed
+			"backup_vnic_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_system_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"software_storage_size_in_gb": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vnic_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createDbNode(d *schema.ResourceData, m interface{}) error {
+	sync := &DbNodeResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	return crud.CreateResource(d, sync)
+}
+
+func readDbNodeResource(d *schema.ResourceData, m interface{}) error {
+	sync := &DbNodeResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	return crud.ReadResource(sync)
+}
+
+func updateDbNode(d *schema.ResourceData, m interface{}) error {
+	sync := &DbNodeResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).databaseClient
+
+	return crud.UpdateResource(d, sync)
+}
+
+// DB nodes are terminated together with their DB system, so destroying the resource only removes it from the state.
+func deleteDbNode(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+func ImportDbNode(d *schema.ResourceData, value interface{}) ([]*schema.ResourceData, error) {
+	err := d.Set("db_node_id", d.Id())
+	return []*schema.ResourceData{d}, err
+}
+
+type DbNodeResourceCrud struct {
+	crud.BaseCrud
+	Client                 *oci_database.DatabaseClient
+	Res                    *oci_database.DbNode
+	DisableNotFoundRetries bool
+}
+
+func (s *DbNodeResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *DbNodeResourceCrud) Create() error {
+	// The DB node already exists. Just set the ID and bring it to the requested power state.
+	s.D.SetId(s.D.Get("db_node_id").(string))
+
+	if err := s.Get(); err != nil {
+		return err
+	}
+
+	state, ok := s.D.GetOkExists("state")
+	if !ok {
+		return nil
+	}
+
+	desiredState := oci_database.DbNodeLifecycleStateEnum(strings.ToUpper(state.(string)))
+	if desiredState == s.Res.LifecycleState {
+		return nil
+	}
+
+	return s.updatePowerState(desiredState, s.D.Timeout(schema.TimeoutCreate))
+}
+
+func (s *DbNodeResourceCrud) Get() error {
+	request := oci_database.GetDbNodeRequest{}
+
+	tmp := s.D.Id()
+	request.DbNodeId = &tmp
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.GetDbNode(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.DbNode
+	return nil
+}
+
+func (s *DbNodeResourceCrud) Update() error {
+	if state, ok := s.D.GetOkExists("state"); ok && s.D.HasChange("state") {
+		desiredState := oci_database.DbNodeLifecycleStateEnum(strings.ToUpper(state.(string)))
+		if err := s.updatePowerState(desiredState, s.D.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+		s.D.SetPartial("state")
+	}
+
+	if s.Res == nil {
+		return s.Get()
+	}
+
+	return nil
+}
+
+// updatePowerState starts or stops the DB node and waits until it reaches the desired lifecycle state.
+func (s *DbNodeResourceCrud) updatePowerState(desiredState oci_database.DbNodeLifecycleStateEnum, timeout time.Duration) error {
+	var action oci_database.DbNodeActionActionEnum
+	switch desiredState {
+	case oci_database.DbNodeLifecycleStateAvailable:
+		action = oci_database.DbNodeActionActionStart
+	case oci_database.DbNodeLifecycleStateStopped:
+		action = oci_database.DbNodeActionActionStop
+	default:
+		return fmt.Errorf("unsupported DB node state '%s', must be one of '%s' or '%s'", desiredState, oci_database.DbNodeLifecycleStateAvailable, oci_database.DbNodeLifecycleStateStopped)
+	}
+
+	request := oci_database.DbNodeActionRequest{}
+
+	tmp := s.D.Id()
+	request.DbNodeId = &tmp
+	request.Action = action
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.DbNodeAction(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.DbNode
+
+	powerStateReached := func() bool { return s.Res.LifecycleState == desiredState }
+	return crud.WaitForResourceCondition(s, powerStateReached, timeout)
+}
+
+func (s *DbNodeResourceCrud) SetData() {
+	if s.Res.BackupVnicId != nil {
+		s.D.Set("backup_vnic_id", *s.Res.BackupVnicId)
+	}
+
+	if s.Res.Id != nil {
+		s.D.Set("db_node_id", *s.Res.Id)
+	}
+
+	if s.Res.DbSystemId != nil {
+		s.D.Set("db_system_id", *s.Res.DbSystemId)
+	}
+
+	if s.Res.Hostname != nil {
+		s.D.Set("hostname", *s.Res.Hostname)
+	}
+
+	if s.Res.SoftwareStorageSizeInGB != nil {
+		s.D.Set("software_storage_size_in_gb", *s.Res.SoftwareStorageSizeInGB)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	if s.Res.VnicId != nil {
+		s.D.Set("vnic_id", *s.Res.VnicId)
+	}
+}
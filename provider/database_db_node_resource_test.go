@@ -0,0 +1,122 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const (
+	DbNodeRequiredOnlyResource = DbNodeResourceDependencies + `
+resource "oci_database_db_node" "test_db_node" {
+	#Required
+	db_node_id = "${data.oci_database_db_nodes.t.db_nodes.0.id}"
+}
+`
+
+	DbNodeResourceConfig = DbNodeResourceDependencies + `
+resource "oci_database_db_node" "test_db_node" {
+	#Required
+	db_node_id = "${data.oci_database_db_nodes.t.db_nodes.0.id}"
+
+	#Optional
+	state = "${var.db_node_state}"
+}
+`
+	DbNodeResourceDependencies = DbHomePatchResourceDependencies + `
+data "oci_database_db_nodes" "t" {
+	compartment_id = "${var.compartment_id}"
+	db_system_id = "${oci_database_db_system.test_db_system.id}"
+}
+`
+)
+
+func TestDatabaseDbNodeResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_database_db_node.test_db_node"
+
+	var resId, resId2 string
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + compartmentIdVariableStr + DbNodeRequiredOnlyResource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "db_node_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "db_system_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "hostname"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet(resourceName, "time_created"),
+					resource.TestCheckResourceAttrSet(resourceName, "vnic_id"),
+
+					func(s *terraform.State) (err error) {
+						resId, err = fromInstanceState(s, resourceName, "id")
+						return err
+					},
+				),
+			},
+
+			// verify stop
+			{
+				Config: config + `
+variable "db_node_state" { default = "STOPPED" }
+
+                ` + compartmentIdVariableStr + DbNodeResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "STOPPED"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+
+			// verify start
+			{
+				Config: config + `
+variable "db_node_state" { default = "available" }
+
+                ` + compartmentIdVariableStr + DbNodeResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+
+			// verify resource import
+			{
+				Config: config + `
+variable "db_node_state" { default = "AVAILABLE" }
+
+                ` + compartmentIdVariableStr + DbNodeResourceConfig,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
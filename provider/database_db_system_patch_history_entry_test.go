@@ -29,7 +29,7 @@ func TestDatabaseDbSystemPatchHistoryEntryResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_database_db_system_patch_history_entries.test_db_system_patch_history_entries"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -29,7 +29,7 @@ func TestDatabaseDbSystemPatchResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_database_db_system_patches.test_db_system_patches"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
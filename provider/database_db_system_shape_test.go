@@ -30,7 +30,7 @@ func TestDatabaseDbSystemShapeResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_database_db_system_shapes.test_db_system_shapes"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -30,7 +30,7 @@ func TestDatabaseDbVersionResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_database_db_versions.test_db_versions"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
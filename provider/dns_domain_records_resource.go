@@ -0,0 +1,261 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+
+	oci_dns "github.com/oracle/oci-go-sdk/dns"
+)
+
+// DomainRecordsResource manages all the records of a domain, of any type, as a whole. Protected records, such as the
+// SOA and NS records at the zone apex, can't be managed and are left out of the items.
+func DomainRecordsResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: ImportDomainRecords,
+		},
+		Timeouts: crud.DefaultTimeout,
+		Create:   createDomainRecords,
+		Read:     readDomainRecords,
+		Update:   updateDomainRecords,
+		Delete:   deleteDomainRecords,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"items": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      recordItemHashCodeForSets,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"rdata": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"rtype": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						// Computed
+						"record_hash": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rrset_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"zone_name_or_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func createDomainRecords(d *schema.ResourceData, m interface{}) error {
+	sync := &DomainRecordsResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+
+	return crud.CreateResource(d, sync)
+}
+
+func readDomainRecords(d *schema.ResourceData, m interface{}) error {
+	sync := &DomainRecordsResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+
+	return crud.ReadResource(sync)
+}
+
+func updateDomainRecords(d *schema.ResourceData, m interface{}) error {
+	sync := &DomainRecordsResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+
+	return crud.UpdateResource(d, sync)
+}
+
+func deleteDomainRecords(d *schema.ResourceData, m interface{}) error {
+	sync := &DomainRecordsResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+	sync.DisableNotFoundRetries = true
+
+	return crud.DeleteResource(d, sync)
+}
+
+// ImportDomainRecords accepts IDs in the form 'zones/{zoneNameOrId}/records/{domain}', which matches the path of the
+// domain in the DNS API.
+func ImportDomainRecords(d *schema.ResourceData, value interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 4 || parts[0] != "zones" || parts[2] != "records" {
+		return nil, fmt.Errorf("illegal import ID '%s', expected 'zones/{zoneNameOrId}/records/{domain}'", d.Id())
+	}
+
+	d.Set("zone_name_or_id", parts[1])
+	d.Set("domain", parts[3])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type DomainRecordsResourceCrud struct {
+	crud.BaseCrud
+	Client                 *oci_dns.DnsClient
+	Res                    *oci_dns.RecordCollection
+	DisableNotFoundRetries bool
+}
+
+func (s *DomainRecordsResourceCrud) ID() string {
+	return fmt.Sprintf("zones/%s/records/%s", s.D.Get("zone_name_or_id").(string), s.D.Get("domain").(string))
+}
+
+// Create replaces any records that already exist in the domain with the configured ones.
+func (s *DomainRecordsResourceCrud) Create() error {
+	request := oci_dns.UpdateDomainRecordsRequest{}
+
+	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
+	request.ZoneNameOrId = &zoneNameOrId
+
+	domain := s.D.Get("domain").(string)
+	request.Domain = &domain
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	request.Items = []oci_dns.RecordDetails{}
+	for _, item := range s.D.Get("items").(*schema.Set).List() {
+		request.Items = append(request.Items, mapToRecordDetails(item.(map[string]interface{}), domain, ""))
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+
+	_, err := s.Client.UpdateDomainRecords(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+func (s *DomainRecordsResourceCrud) Get() error {
+	request := oci_dns.GetDomainRecordsRequest{}
+
+	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
+	request.ZoneNameOrId = &zoneNameOrId
+
+	domain := s.D.Get("domain").(string)
+	request.Domain = &domain
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+
+	response, err := s.Client.GetDomainRecords(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.RecordCollection
+	request.Page = response.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.GetDomainRecords(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return nil
+}
+
+// Update only adds and removes the records that changed, so the others keep their record hashes.
+func (s *DomainRecordsResourceCrud) Update() error {
+	request := oci_dns.PatchDomainRecordsRequest{}
+
+	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
+	request.ZoneNameOrId = &zoneNameOrId
+
+	domain := s.D.Get("domain").(string)
+	request.Domain = &domain
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	request.Items = mapToRecordOperations(s.D, domain, "")
+	if len(request.Items) == 0 {
+		return s.Get()
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+
+	_, err := s.Client.PatchDomainRecords(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+func (s *DomainRecordsResourceCrud) Delete() error {
+	request := oci_dns.DeleteDomainRecordsRequest{}
+
+	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
+	request.ZoneNameOrId = &zoneNameOrId
+
+	domain := s.D.Get("domain").(string)
+	request.Domain = &domain
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+
+	_, err := s.Client.DeleteDomainRecords(context.Background(), request)
+	return err
+}
+
+func (s *DomainRecordsResourceCrud) SetData() {
+	s.D.Set("items", recordItemsToSet(s.Res.Items, s.D.Get("items").(*schema.Set), true))
+}
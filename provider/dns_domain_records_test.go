@@ -0,0 +1,122 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const (
+	DomainRecordsResourceConfig = RecordResourceDependencies + `
+resource "oci_dns_domain_records" "test_domain_records" {
+	#Required
+	zone_name_or_id = "${oci_dns_zone.test_zone.name}"
+	domain = "${data.oci_identity_tenancy.test_tenancy.name}.oci-test"
+
+	items {
+		rdata = "192.168.0.1"
+		rtype = "A"
+		ttl = 3600
+	}
+	items {
+		rdata = "${var.domain_records_items_rdata}"
+		rtype = "${var.domain_records_items_rtype}"
+		ttl = 3600
+	}
+
+	#Optional
+	compartment_id = "${var.compartment_id}"
+}
+`
+	DomainRecordsPropertyVariables = `
+variable "domain_records_items_rdata" { default = "192.168.0.2" }
+variable "domain_records_items_rtype" { default = "A" }
+
+`
+)
+
+func TestDnsDomainRecordsResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_dns_domain_records.test_domain_records"
+
+	var resId, resId2 string
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + DomainRecordsPropertyVariables + compartmentIdVariableStr + DomainRecordsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttrSet(resourceName, "domain"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "2"),
+					TestCheckResourceAttributesEqual(resourceName, "zone_name_or_id", "oci_dns_zone.test_zone", "name"),
+
+					func(s *terraform.State) (err error) {
+						resId, err = fromInstanceState(s, resourceName, "id")
+						return err
+					},
+				),
+			},
+
+			// verify updates to updatable parameters, including a record that the service rewrites
+			{
+				Config: config + `
+variable "domain_records_items_rdata" { default = "2001:0db8:85a3:0000:0000:8a2e:0370:7334" }
+variable "domain_records_items_rtype" { default = "AAAA" }
+
+                ` + compartmentIdVariableStr + DomainRecordsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "items.#", "2"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+
+			// verify the records as the service returns them
+			{
+				Config: config + `
+variable "domain_records_items_rdata" { default = "2001:db8:85a3::8a2e:370:7334" }
+variable "domain_records_items_rtype" { default = "AAAA" }
+
+                ` + compartmentIdVariableStr + DomainRecordsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "items.#", "2"),
+				),
+			},
+
+			// verify resource import
+			{
+				Config: config + `
+variable "domain_records_items_rdata" { default = "2001:db8:85a3::8a2e:370:7334" }
+variable "domain_records_items_rtype" { default = "AAAA" }
+
+                ` + compartmentIdVariableStr + DomainRecordsResourceConfig,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"compartment_id",
+				},
+				ResourceName: resourceName,
+			},
+		},
+	})
+}
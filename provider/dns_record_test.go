@@ -76,7 +76,7 @@ func TestDnsRecordsResource_basic(t *testing.T) {
 
 	var resId, resId2 string
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -197,7 +197,7 @@ func TestDnsRecordsResource_diffSuppression(t *testing.T) {
 
 	resourceName := "oci_dns_record.test_record"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -283,7 +283,7 @@ func TestDnsRecordsResource_badUpdate(t *testing.T) {
 
 	resourceName := "oci_dns_record.test_record"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -0,0 +1,405 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+
+	oci_dns "github.com/oracle/oci-go-sdk/dns"
+)
+
+// RRSetResource manages all the records of one type for a domain, such as all the A records of a name, as a whole.
+// Protected records can't be managed and are left out of the items.
+func RRSetResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: ImportRRSet,
+		},
+		Timeouts: crud.DefaultTimeout,
+		Create:   createRRSet,
+		Read:     readRRSet,
+		Update:   updateRRSet,
+		Delete:   deleteRRSet,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"items": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      recordItemHashCodeForSets,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"rdata": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						// Computed
+						"record_hash": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rrset_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"rtype": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone_name_or_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func createRRSet(d *schema.ResourceData, m interface{}) error {
+	sync := &RRSetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+
+	return crud.CreateResource(d, sync)
+}
+
+func readRRSet(d *schema.ResourceData, m interface{}) error {
+	sync := &RRSetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+
+	return crud.ReadResource(sync)
+}
+
+func updateRRSet(d *schema.ResourceData, m interface{}) error {
+	sync := &RRSetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+
+	return crud.UpdateResource(d, sync)
+}
+
+func deleteRRSet(d *schema.ResourceData, m interface{}) error {
+	sync := &RRSetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).dnsClient
+	sync.DisableNotFoundRetries = true
+
+	return crud.DeleteResource(d, sync)
+}
+
+// ImportRRSet accepts IDs in the form 'zones/{zoneNameOrId}/records/{domain}/{rtype}', which matches the path of the
+// RRSet in the DNS API.
+func ImportRRSet(d *schema.ResourceData, value interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 5 || parts[0] != "zones" || parts[2] != "records" {
+		return nil, fmt.Errorf("illegal import ID '%s', expected 'zones/{zoneNameOrId}/records/{domain}/{rtype}'", d.Id())
+	}
+
+	d.Set("zone_name_or_id", parts[1])
+	d.Set("domain", parts[3])
+	d.Set("rtype", parts[4])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type RRSetResourceCrud struct {
+	crud.BaseCrud
+	Client                 *oci_dns.DnsClient
+	Res                    *oci_dns.RrSet
+	DisableNotFoundRetries bool
+}
+
+func (s *RRSetResourceCrud) ID() string {
+	return fmt.Sprintf("zones/%s/records/%s/%s", s.D.Get("zone_name_or_id").(string), s.D.Get("domain").(string), s.D.Get("rtype").(string))
+}
+
+// Create replaces any records that already exist in the RRSet with the configured ones.
+func (s *RRSetResourceCrud) Create() error {
+	request := oci_dns.UpdateRRSetRequest{}
+
+	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
+	request.ZoneNameOrId = &zoneNameOrId
+
+	domain := s.D.Get("domain").(string)
+	request.Domain = &domain
+
+	rtype := s.D.Get("rtype").(string)
+	request.Rtype = &rtype
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	request.Items = []oci_dns.RecordDetails{}
+	for _, item := range s.D.Get("items").(*schema.Set).List() {
+		request.Items = append(request.Items, mapToRecordDetails(item.(map[string]interface{}), domain, rtype))
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+
+	_, err := s.Client.UpdateRRSet(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+func (s *RRSetResourceCrud) Get() error {
+	request := oci_dns.GetRRSetRequest{}
+
+	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
+	request.ZoneNameOrId = &zoneNameOrId
+
+	domain := s.D.Get("domain").(string)
+	request.Domain = &domain
+
+	rtype := s.D.Get("rtype").(string)
+	request.Rtype = &rtype
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+
+	response, err := s.Client.GetRRSet(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.RrSet
+	request.Page = response.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.GetRRSet(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return nil
+}
+
+// Update only adds and removes the records that changed, so the others keep their record hashes.
+func (s *RRSetResourceCrud) Update() error {
+	request := oci_dns.PatchRRSetRequest{}
+
+	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
+	request.ZoneNameOrId = &zoneNameOrId
+
+	domain := s.D.Get("domain").(string)
+	request.Domain = &domain
+
+	rtype := s.D.Get("rtype").(string)
+	request.Rtype = &rtype
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	request.Items = mapToRecordOperations(s.D, domain, rtype)
+	if len(request.Items) == 0 {
+		return s.Get()
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+
+	_, err := s.Client.PatchRRSet(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+func (s *RRSetResourceCrud) Delete() error {
+	request := oci_dns.DeleteRRSetRequest{}
+
+	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
+	request.ZoneNameOrId = &zoneNameOrId
+
+	domain := s.D.Get("domain").(string)
+	request.Domain = &domain
+
+	rtype := s.D.Get("rtype").(string)
+	request.Rtype = &rtype
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "dns")
+
+	_, err := s.Client.DeleteRRSet(context.Background(), request)
+	return err
+}
+
+func (s *RRSetResourceCrud) SetData() {
+	s.D.Set("items", recordItemsToSet(s.Res.Items, s.D.Get("items").(*schema.Set), false))
+}
+
+// recordItemHashCodeForSets identifies a record by the values that can be configured, so the computed values read
+// back from the service don't cause a diff.
+func recordItemHashCodeForSets(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	if rtype, ok := m["rtype"]; ok && rtype != "" {
+		buf.WriteString(fmt.Sprintf("%s-", strings.ToUpper(rtype.(string))))
+	}
+	buf.WriteString(fmt.Sprintf("%s-", m["rdata"].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m["ttl"].(int)))
+
+	return hashcode.String(buf.String())
+}
+
+// mapToRecordDetails converts a configured item into a record of the given domain. The rtype argument is used when the
+// item doesn't have its own rtype.
+func mapToRecordDetails(raw map[string]interface{}, domain string, rtype string) oci_dns.RecordDetails {
+	result := oci_dns.RecordDetails{}
+
+	result.Domain = &domain
+
+	if itemRtype, ok := raw["rtype"]; ok && itemRtype != "" {
+		rtype = itemRtype.(string)
+	}
+	result.Rtype = &rtype
+
+	rdata := raw["rdata"].(string)
+	result.Rdata = &rdata
+
+	ttl := raw["ttl"].(int)
+	result.Ttl = &ttl
+
+	return result
+}
+
+// mapToRecordOperations returns the operations that turn the items in the state into the configured items. Removed
+// records are identified by their record hash.
+func mapToRecordOperations(d *schema.ResourceData, domain string, rtype string) []oci_dns.RecordOperation {
+	o, n := d.GetChange("items")
+	oldItems := o.(*schema.Set)
+	newItems := n.(*schema.Set)
+
+	result := []oci_dns.RecordOperation{}
+
+	for _, item := range oldItems.Difference(newItems).List() {
+		recordHash := item.(map[string]interface{})["record_hash"].(string)
+		result = append(result, oci_dns.RecordOperation{
+			Operation:  oci_dns.RecordOperationOperationRemove,
+			RecordHash: &recordHash,
+		})
+	}
+
+	for _, item := range newItems.Difference(oldItems).List() {
+		details := mapToRecordDetails(item.(map[string]interface{}), domain, rtype)
+		result = append(result, oci_dns.RecordOperation{
+			Operation: oci_dns.RecordOperationOperationAdd,
+			Domain:    details.Domain,
+			Rdata:     details.Rdata,
+			Rtype:     details.Rtype,
+			Ttl:       details.Ttl,
+		})
+	}
+
+	return result
+}
+
+// recordItemsToSet converts the records read from the service into items. The service may rewrite rdata, for example
+// by appending a "." to host names, so the configured rdata is kept when it only differs from the service's by such a
+// rewrite. Protected records are left out, since they can't be managed.
+func recordItemsToSet(records []oci_dns.Record, configured *schema.Set, includeRtype bool) *schema.Set {
+	result := schema.NewSet(recordItemHashCodeForSets, []interface{}{})
+
+	for _, record := range records {
+		if record.IsProtected != nil && *record.IsProtected {
+			continue
+		}
+
+		item := map[string]interface{}{}
+
+		rtype := ""
+		if record.Rtype != nil {
+			rtype = *record.Rtype
+		}
+
+		if includeRtype {
+			item["rtype"] = rtype
+		}
+
+		if record.Rdata != nil {
+			item["rdata"] = *record.Rdata
+
+			for _, raw := range configured.List() {
+				configuredItem := raw.(map[string]interface{})
+
+				configuredRtype, hasRtype := configuredItem["rtype"].(string)
+				if includeRtype && (!hasRtype || !strings.EqualFold(configuredRtype, rtype)) {
+					continue
+				}
+
+				if configuredRdata := configuredItem["rdata"].(string); normalizeRData(strings.ToUpper(rtype), configuredRdata) == normalizeRData(strings.ToUpper(rtype), *record.Rdata) {
+					item["rdata"] = configuredRdata
+					if includeRtype {
+						item["rtype"] = configuredRtype
+					}
+					break
+				}
+			}
+		}
+
+		if record.Ttl != nil {
+			item["ttl"] = *record.Ttl
+		}
+
+		if record.RecordHash != nil {
+			item["record_hash"] = *record.RecordHash
+		}
+
+		if record.RrsetVersion != nil {
+			item["rrset_version"] = *record.RrsetVersion
+		}
+
+		result.Add(item)
+	}
+
+	return result
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_dns "github.com/oracle/oci-go-sdk/dns"
+)
+
+const (
+	RRSetResourceConfig = RecordResourceDependencies + `
+resource "oci_dns_rrset" "test_rrset" {
+	#Required
+	zone_name_or_id = "${oci_dns_zone.test_zone.name}"
+	domain = "${data.oci_identity_tenancy.test_tenancy.name}.oci-test"
+	rtype = "A"
+
+	items {
+		rdata = "192.168.0.1"
+		ttl = "${var.rrset_items_ttl}"
+	}
+	items {
+		rdata = "${var.rrset_items_rdata}"
+		ttl = "${var.rrset_items_ttl}"
+	}
+
+	#Optional
+	compartment_id = "${var.compartment_id}"
+}
+`
+	RRSetPropertyVariables = `
+variable "rrset_items_rdata" { default = "192.168.0.2" }
+variable "rrset_items_ttl" { default = 3600 }
+
+`
+)
+
+func TestDnsRRSetResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_dns_rrset.test_rrset"
+
+	var resId, resId2 string
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + RRSetPropertyVariables + compartmentIdVariableStr + RRSetResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttrSet(resourceName, "domain"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rtype", "A"),
+					TestCheckResourceAttributesEqual(resourceName, "zone_name_or_id", "oci_dns_zone.test_zone", "name"),
+
+					func(s *terraform.State) (err error) {
+						resId, err = fromInstanceState(s, resourceName, "id")
+						return err
+					},
+				),
+			},
+
+			// verify updates to updatable parameters
+			{
+				Config: config + `
+variable "rrset_items_rdata" { default = "192.168.0.3" }
+variable "rrset_items_ttl" { default = 1000 }
+
+                ` + compartmentIdVariableStr + RRSetResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "items.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rtype", "A"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+
+			// verify resource import
+			{
+				Config: config + `
+variable "rrset_items_rdata" { default = "192.168.0.3" }
+variable "rrset_items_ttl" { default = 1000 }
+
+                ` + compartmentIdVariableStr + RRSetResourceConfig,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"compartment_id",
+				},
+				ResourceName: resourceName,
+			},
+		},
+	})
+}
+
+func TestUnitImportRRSet(t *testing.T) {
+	resourceSchema := RRSetResource().Schema
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	d.SetId("zones/example.com/records/www.example.com/A")
+
+	results, err := ImportRRSet(d, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error importing RRSet: %v", err)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 imported resource, got %d", len(results))
+	}
+
+	for key, expected := range map[string]string{"zone_name_or_id": "example.com", "domain": "www.example.com", "rtype": "A"} {
+		if actual := results[0].Get(key).(string); actual != expected {
+			t.Errorf("Expected %s '%s', got '%s'", key, expected, actual)
+		}
+	}
+
+	for _, id := range []string{
+		"www.example.com",
+		"zones/example.com/records/www.example.com",
+		"zones/example.com/rrsets/www.example.com/A",
+	} {
+		d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+		d.SetId(id)
+
+		if _, err := ImportRRSet(d, nil); err == nil {
+			t.Errorf("Expected an error importing RRSet with ID '%s'", id)
+		}
+	}
+}
+
+func TestUnitRecordItemsToSet(t *testing.T) {
+	configured := schema.NewSet(recordItemHashCodeForSets, []interface{}{
+		map[string]interface{}{"rdata": "alias.example.com", "rtype": "cname", "ttl": 300},
+	})
+
+	records := []oci_dns.Record{
+		{Rdata: oci_common.String("alias.example.com."), Rtype: oci_common.String("CNAME"), Ttl: oci_common.Int(300), RecordHash: oci_common.String("hash1"), IsProtected: oci_common.Bool(false)},
+		{Rdata: oci_common.String("ns1.example.com."), Rtype: oci_common.String("NS"), Ttl: oci_common.Int(86400), RecordHash: oci_common.String("hash2"), IsProtected: oci_common.Bool(true)},
+		{Rdata: oci_common.String("10.0.0.1"), Rtype: oci_common.String("A"), Ttl: oci_common.Int(300), RecordHash: oci_common.String("hash3"), IsProtected: oci_common.Bool(false)},
+	}
+
+	result := recordItemsToSet(records, configured, true)
+
+	if result.Len() != 2 {
+		t.Fatalf("Expected 2 items without the protected record, got %d: %v", result.Len(), result.List())
+	}
+
+	// The rewritten CNAME is matched against the configured item, so its hash is unchanged
+	if !result.Contains(configured.List()[0]) {
+		t.Errorf("Expected the configured CNAME item to be kept, got %v", result.List())
+	}
+
+	if !result.Contains(map[string]interface{}{"rdata": "10.0.0.1", "rtype": "A", "ttl": 300}) {
+		t.Errorf("Expected the A record to be read as it is, got %v", result.List())
+	}
+}
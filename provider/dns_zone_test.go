@@ -73,7 +73,7 @@ func TestDnsZoneResource_basic(t *testing.T) {
 
 	var resId string
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -37,7 +37,7 @@ func TestEmailSenderResource_basic(t *testing.T) {
 	datasourceName := "data.oci_email_senders.test_senders"
 	singularDatasourceName := "data.oci_email_sender.test_sender"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
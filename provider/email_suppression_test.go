@@ -39,7 +39,7 @@ func TestEmailSuppressionResource_basic(t *testing.T) {
 	datasourceName := "data.oci_email_suppressions.test_suppressions"
 	singularDatasourceName := "data.oci_email_suppression.test_suppression"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -10,6 +10,8 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
 )
 
 const examplesTestStateFile = "test_examples.tfstate"
@@ -37,6 +39,11 @@ func TestExamplesApply(t *testing.T) {
 }
 
 func RunExamples(t *testing.T, planOnly bool) {
+	// The examples are run with the terraform binary against a tenancy, so their requests can't be replayed
+	if os.Getenv(resource.TestEnvVar) == "" || os.Getenv(httpModeEnvironmentVariable) == httpModeReplay {
+		t.Skipf("Examples skipped unless env '%s' set, and %s is not '%s'", resource.TestEnvVar, httpModeEnvironmentVariable, httpModeReplay)
+	}
+
 	rootPath := "../docs/examples"
 	log.Printf("Testing examples under %v", rootPath)
 
@@ -0,0 +1,444 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// ExportOptions are the options of ExportCompartment.
+type ExportOptions struct {
+	CompartmentId string
+	OutputDir     string
+	// Also export the sub-compartments of the compartment, and their resources
+	Recursive bool
+	// Also write a state file of the exported resources, so that they don't need to be imported
+	GenerateState bool
+}
+
+// exportResourceType is a type of resource that is discovered with a list data source.
+type exportResourceType struct {
+	resourceType  string
+	dataSource    string
+	listAttribute string
+	// The type of the resources that scope the list, such as the VCN of subnets, and the argument of the data source
+	// that is set to their ID
+	parentType     string
+	parentArgument string
+	// The attributes of the parent that are the IDs of its default resources, which are not exported, such as the
+	// default security list of a VCN
+	parentDefaults []string
+	// The buckets are listed and read by namespace and name, rather than by ID
+	byNamespaceAndName bool
+}
+
+// exportResourceTypes are the exported types, in the order they are discovered. Parents are discovered first.
+var exportResourceTypes = []exportResourceType{
+	{resourceType: "oci_identity_compartment", dataSource: "oci_identity_compartments", listAttribute: "compartments"},
+	{resourceType: "oci_identity_policy", dataSource: "oci_identity_policies", listAttribute: "policies"},
+	{resourceType: "oci_core_vcn", dataSource: "oci_core_vcns", listAttribute: "virtual_networks"},
+	{resourceType: "oci_core_internet_gateway", dataSource: "oci_core_internet_gateways", listAttribute: "gateways", parentType: "oci_core_vcn", parentArgument: "vcn_id"},
+	{resourceType: "oci_core_route_table", dataSource: "oci_core_route_tables", listAttribute: "route_tables", parentType: "oci_core_vcn", parentArgument: "vcn_id", parentDefaults: []string{"default_route_table_id"}},
+	{resourceType: "oci_core_security_list", dataSource: "oci_core_security_lists", listAttribute: "security_lists", parentType: "oci_core_vcn", parentArgument: "vcn_id", parentDefaults: []string{"default_security_list_id"}},
+	{resourceType: "oci_core_subnet", dataSource: "oci_core_subnets", listAttribute: "subnets", parentType: "oci_core_vcn", parentArgument: "vcn_id"},
+	{resourceType: "oci_core_instance", dataSource: "oci_core_instances", listAttribute: "instances"},
+	{resourceType: "oci_core_volume", dataSource: "oci_core_volumes", listAttribute: "volumes"},
+	{resourceType: "oci_load_balancer_load_balancer", dataSource: "oci_load_balancer_load_balancers", listAttribute: "load_balancers"},
+	{resourceType: "oci_objectstorage_bucket", dataSource: "oci_objectstorage_bucket_summaries", listAttribute: "bucket_summaries", byNamespaceAndName: true},
+}
+
+// The resources in these states are not exported
+var exportSkippedStates = map[string]bool{
+	"TERMINATING": true,
+	"TERMINATED":  true,
+	"DELETING":    true,
+	"DELETED":     true,
+}
+
+// exportedResource is a resource that was read with the Read of its resource type, so that its attributes are
+// mapped by the same SetData as when it is managed by Terraform.
+type exportedResource struct {
+	resourceType string
+	name         string
+	importId     string
+	// The name of the file that the resource is written to, after its compartment
+	fileName string
+	d        *schema.ResourceData
+}
+
+func (r *exportedResource) address() string {
+	return r.resourceType + "." + r.name
+}
+
+type resourceExporter struct {
+	m          interface{}
+	options    ExportOptions
+	resources  map[string]*schema.Resource
+	namespace  string
+	exported   []*exportedResource
+	usedNames  map[string]bool
+	fileNames  []string
+	references map[string]string
+}
+
+// ExportCompartment discovers the resources of a compartment with the given provider clients, and writes a .tf file of
+// each compartment, the terraform import commands of the resources and, optionally, a state file to the output
+// directory. The configuration has no diff with the resources on the first plan.
+func ExportCompartment(m interface{}, options ExportOptions) error {
+	e := &resourceExporter{
+		m:          m,
+		options:    options,
+		resources:  resourcesMapWithRetryBlock(),
+		usedNames:  map[string]bool{},
+		references: map[string]string{},
+	}
+
+	if err := e.exportCompartment(options.CompartmentId, "main"); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(options.OutputDir, 0755); err != nil {
+		return err
+	}
+	return e.write()
+}
+
+func (e *resourceExporter) exportCompartment(compartmentId string, fileName string) error {
+	log.Printf("[INFO] Exporting compartment %s", compartmentId)
+	e.fileNames = append(e.fileNames, fileName)
+
+	exportedByType := map[string][]*exportedResource{}
+	for _, resourceType := range exportResourceTypes {
+		parents := []*exportedResource{nil}
+		if resourceType.parentType != "" {
+			parents = exportedByType[resourceType.parentType]
+		}
+
+		for _, parent := range parents {
+			items, err := e.list(resourceType, compartmentId, parent)
+			if err != nil {
+				return err
+			}
+
+			for _, item := range items {
+				exported, err := e.read(resourceType, item, fileName)
+				if err != nil {
+					return err
+				}
+				if exported != nil {
+					exportedByType[resourceType.resourceType] = append(exportedByType[resourceType.resourceType], exported)
+				}
+			}
+		}
+	}
+
+	if e.options.Recursive {
+		for _, compartment := range exportedByType["oci_identity_compartment"] {
+			if err := e.exportCompartment(compartment.d.Id(), compartment.name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// list returns the items of the list data source of the resource type, without the ones that are deleted or that
+// are the defaults of the parent.
+func (e *resourceExporter) list(resourceType exportResourceType, compartmentId string, parent *exportedResource) ([]map[string]interface{}, error) {
+	dataSource := dataSourcesMap()[resourceType.dataSource]
+	d := dataSource.Data(nil)
+	d.Set("compartment_id", compartmentId)
+
+	skippedIds := map[string]bool{}
+	if parent != nil {
+		d.Set(resourceType.parentArgument, parent.d.Id())
+		for _, attribute := range resourceType.parentDefaults {
+			skippedIds[parent.d.Get(attribute).(string)] = true
+		}
+	}
+
+	if resourceType.byNamespaceAndName {
+		namespace, err := e.getNamespace()
+		if err != nil {
+			return nil, err
+		}
+		d.Set("namespace", namespace)
+	}
+
+	if err := dataSource.Read(d, e.m); err != nil {
+		return nil, fmt.Errorf("can not list %s in compartment '%s': %v", resourceType.dataSource, compartmentId, err)
+	}
+
+	result := []map[string]interface{}{}
+	for _, raw := range d.Get(resourceType.listAttribute).([]interface{}) {
+		item := raw.(map[string]interface{})
+		if state, ok := item["state"].(string); ok && exportSkippedStates[state] {
+			continue
+		}
+		if id, ok := item["id"].(string); ok && skippedIds[id] {
+			continue
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+func (e *resourceExporter) getNamespace() (string, error) {
+	if e.namespace != "" {
+		return e.namespace, nil
+	}
+
+	dataSource := dataSourcesMap()["oci_objectstorage_namespace"]
+	d := dataSource.Data(nil)
+	if err := dataSource.Read(d, e.m); err != nil {
+		return "", fmt.Errorf("can not get the Object Storage namespace: %v", err)
+	}
+	e.namespace = d.Get("namespace").(string)
+	return e.namespace, nil
+}
+
+// read reads a listed item with the Read of its resource type, or returns nil if the resource is gone.
+func (e *resourceExporter) read(resourceType exportResourceType, item map[string]interface{}, fileName string) (*exportedResource, error) {
+	resource := e.resources[resourceType.resourceType]
+	d := resource.Data(nil)
+
+	var id string
+	if resourceType.byNamespaceAndName {
+		d.Set("namespace", item["namespace"])
+		d.Set("name", item["name"])
+		id = fmt.Sprintf("%s/%s", item["namespace"], item["name"])
+	} else {
+		id = item["id"].(string)
+	}
+	d.SetId(id)
+
+	if err := resource.Read(d, e.m); err != nil {
+		return nil, fmt.Errorf("can not read %s '%s': %v", resourceType.resourceType, id, err)
+	}
+	if d.Id() == "" {
+		return nil, nil
+	}
+
+	exported := &exportedResource{
+		resourceType: resourceType.resourceType,
+		name:         e.resourceName(resourceType.resourceType, d),
+		importId:     id,
+		fileName:     fileName,
+		d:            d,
+	}
+	e.exported = append(e.exported, exported)
+	if !resourceType.byNamespaceAndName {
+		e.references[id] = fmt.Sprintf("${%s.id}", exported.address())
+	}
+
+	return exported, nil
+}
+
+var invalidNameCharacters = regexp.MustCompile("[^a-z0-9_]+")
+
+// resourceName returns a unique name for the resource, after its display name or name.
+func (e *resourceExporter) resourceName(resourceType string, d *schema.ResourceData) string {
+	name := ""
+	for _, attribute := range []string{"display_name", "name"} {
+		if value, ok := d.GetOk(attribute); ok {
+			name = value.(string)
+			break
+		}
+	}
+
+	name = strings.Trim(invalidNameCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = strings.TrimPrefix(resourceType, "oci_") + "_" + name
+		name = strings.TrimSuffix(name, "_")
+	}
+
+	result := name
+	for i := 2; e.usedNames[resourceType+"."+result]; i++ {
+		result = fmt.Sprintf("%s_%d", name, i)
+	}
+	e.usedNames[resourceType+"."+result] = true
+	return result
+}
+
+func (e *resourceExporter) write() error {
+	imports := &bytes.Buffer{}
+	imports.WriteString("#!/bin/sh\nset -e\n\n")
+
+	state := terraform.NewState()
+	writtenFiles := map[string]bool{}
+	for _, fileName := range e.fileNames {
+		// A sub-compartment may be named like the file of the exported compartment
+		if writtenFiles[fileName] {
+			continue
+		}
+		writtenFiles[fileName] = true
+
+		config := &bytes.Buffer{}
+		for _, exported := range e.exported {
+			if exported.fileName != fileName {
+				continue
+			}
+
+			writeResourceHCL(config, exported.resourceType, exported.name, e.resources[exported.resourceType].Schema, exported.d, e.references)
+			fmt.Fprintf(imports, "terraform import %s %s\n", exported.address(), exported.importId)
+			state.RootModule().Resources[exported.address()] = &terraform.ResourceState{
+				Type:     exported.resourceType,
+				Provider: "provider.oci",
+				Primary:  exported.d.State(),
+			}
+		}
+
+		if config.Len() == 0 {
+			continue
+		}
+		if err := ioutil.WriteFile(filepath.Join(e.options.OutputDir, fileName+".tf"), config.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(e.options.OutputDir, "import.sh"), imports.Bytes(), 0755); err != nil {
+		return err
+	}
+
+	if e.options.GenerateState {
+		file, err := os.Create(filepath.Join(e.options.OutputDir, "terraform.tfstate"))
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		return terraform.WriteState(state, file)
+	}
+
+	return nil
+}
+
+// writeResourceHCL writes the arguments of a resource, which are the fields of its schema that can be set in a
+// configuration. The values that are IDs of other exported resources are replaced by references to them.
+func writeResourceHCL(w *bytes.Buffer, resourceType string, name string, resourceSchema map[string]*schema.Schema, d *schema.ResourceData, references map[string]string) {
+	values := map[string]interface{}{}
+	for field := range resourceSchema {
+		values[field] = d.Get(field)
+	}
+
+	fmt.Fprintf(w, "resource \"%s\" \"%s\" {\n", resourceType, name)
+	writeArgumentsHCL(w, resourceSchema, values, references, "  ")
+	w.WriteString("}\n\n")
+}
+
+func writeArgumentsHCL(w *bytes.Buffer, fieldsSchema map[string]*schema.Schema, values map[string]interface{}, references map[string]string, indent string) {
+	fields := []string{}
+	for field := range fieldsSchema {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	written := map[string]bool{}
+	for _, field := range fields {
+		fieldSchema := fieldsSchema[field]
+		value := values[field]
+
+		if !isExportedArgument(fieldSchema, value) {
+			continue
+		}
+		conflicts := false
+		for _, conflictingField := range fieldSchema.ConflictsWith {
+			conflicts = conflicts || written[conflictingField]
+		}
+		if conflicts {
+			continue
+		}
+		written[field] = true
+
+		if set, ok := value.(*schema.Set); ok {
+			value = set.List()
+		}
+
+		// Nested resources are written as blocks
+		if elem, ok := fieldSchema.Elem.(*schema.Resource); ok {
+			for _, item := range value.([]interface{}) {
+				itemValues, _ := item.(map[string]interface{})
+				fmt.Fprintf(w, "%s%s {\n", indent, field)
+				writeArgumentsHCL(w, elem.Schema, itemValues, references, indent+"  ")
+				fmt.Fprintf(w, "%s}\n", indent)
+			}
+			continue
+		}
+
+		fmt.Fprintf(w, "%s%s = %s\n", indent, field, hclValue(value, references, indent))
+	}
+}
+
+// isExportedArgument returns whether the field can be set in a configuration, and has a value that differs from what
+// Terraform would use if it were not set.
+func isExportedArgument(fieldSchema *schema.Schema, value interface{}) bool {
+	if (!fieldSchema.Required && !fieldSchema.Optional) || fieldSchema.Deprecated != "" || fieldSchema.Removed != "" {
+		return false
+	}
+	if fieldSchema.Required {
+		return true
+	}
+	if fieldSchema.Default != nil {
+		return !reflect.DeepEqual(value, fieldSchema.Default)
+	}
+	return !isZeroValue(value)
+}
+
+func isZeroValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case *schema.Set:
+		return v.Len() == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return reflect.DeepEqual(value, reflect.Zero(reflect.TypeOf(value)).Interface())
+}
+
+var hclStringEscaper = strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n", "${", "$${")
+
+func hclValue(value interface{}, references map[string]string, indent string) string {
+	switch v := value.(type) {
+	case string:
+		if reference, ok := references[v]; ok {
+			return fmt.Sprintf("\"%s\"", reference)
+		}
+		return fmt.Sprintf("\"%s\"", hclStringEscaper.Replace(v))
+	case []interface{}:
+		items := []string{}
+		for _, item := range v {
+			items = append(items, hclValue(item, references, indent))
+		}
+		return fmt.Sprintf("[%s]", strings.Join(items, ", "))
+	case *schema.Set:
+		return hclValue(v.List(), references, indent)
+	case map[string]interface{}:
+		keys := []string{}
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		result := "{\n"
+		for _, key := range keys {
+			result += fmt.Sprintf("%s  \"%s\" = %s\n", indent, hclStringEscaper.Replace(key), hclValue(v[key], references, indent+"  "))
+		}
+		return result + indent + "}"
+	}
+	return fmt.Sprint(value)
+}
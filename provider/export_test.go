@@ -0,0 +1,186 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_core "github.com/oracle/oci-go-sdk/core"
+	oci_identity "github.com/oracle/oci-go-sdk/identity"
+	oci_load_balancer "github.com/oracle/oci-go-sdk/loadbalancer"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+)
+
+// newTestExportService returns a local service with a VCN and its default security list, and nothing else.
+func newTestExportService() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		vcn := `{"id": "ocid1.vcn.oc1..test", "cidrBlock": "10.0.0.0/16", "compartmentId": "ocid1.compartment.oc1..test", "displayName": "Test VCN", "dnsLabel": "testvcn", "lifecycleState": "AVAILABLE", "defaultSecurityListId": "ocid1.securitylist.oc1..default", "freeformTags": {"Department": "Finance"}}`
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/vcns"):
+			fmt.Fprintf(w, "[%s]", vcn)
+		case strings.HasSuffix(r.URL.Path, "/vcns/ocid1.vcn.oc1..test"):
+			fmt.Fprint(w, vcn)
+		case strings.HasSuffix(r.URL.Path, "/securityLists"):
+			fmt.Fprint(w, `[{"id": "ocid1.securitylist.oc1..default", "compartmentId": "ocid1.compartment.oc1..test", "vcnId": "ocid1.vcn.oc1..test", "lifecycleState": "AVAILABLE", "egressSecurityRules": [], "ingressSecurityRules": []}]`)
+		case r.URL.Path == "/n" || r.URL.Path == "/n/":
+			fmt.Fprint(w, `"testnamespace"`)
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	}))
+}
+
+func newTestExportClients(host string) *OracleClients {
+	baseClient := oci_common.DefaultBaseClientWithSigner(testRequestSigner{})
+	baseClient.Host = host
+	baseClient.UserAgent = "test"
+
+	return &OracleClients{
+		identityClient:       &oci_identity.IdentityClient{BaseClient: baseClient},
+		virtualNetworkClient: &oci_core.VirtualNetworkClient{BaseClient: baseClient},
+		computeClient:        &oci_core.ComputeClient{BaseClient: baseClient},
+		blockstorageClient:   &oci_core.BlockstorageClient{BaseClient: baseClient},
+		loadBalancerClient:   &oci_load_balancer.LoadBalancerClient{BaseClient: baseClient},
+		objectStorageClient:  &oci_object_storage.ObjectStorageClient{BaseClient: baseClient},
+	}
+}
+
+func TestUnitExportCompartment(t *testing.T) {
+	server := newTestExportService()
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "export")
+	if err != nil {
+		t.Fatalf("Unable to create a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	err = ExportCompartment(newTestExportClients(server.URL), ExportOptions{CompartmentId: "ocid1.compartment.oc1..test", OutputDir: dir, GenerateState: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	config, err := ioutil.ReadFile(filepath.Join(dir, "main.tf"))
+	if err != nil {
+		t.Fatalf("Unable to read the configuration: %v", err)
+	}
+	expected := `resource "oci_core_vcn" "test_vcn" {
+  cidr_block = "10.0.0.0/16"
+  compartment_id = "ocid1.compartment.oc1..test"
+  display_name = "Test VCN"
+  dns_label = "testvcn"
+  freeform_tags = {
+    "Department" = "Finance"
+  }
+}
+
+`
+	// The default security list of the VCN is not exported
+	if string(config) != expected {
+		t.Errorf("Expected the configuration:\n%s\ngot:\n%s", expected, config)
+	}
+
+	imports, err := ioutil.ReadFile(filepath.Join(dir, "import.sh"))
+	if err != nil {
+		t.Fatalf("Unable to read the import commands: %v", err)
+	}
+	if !strings.Contains(string(imports), "terraform import oci_core_vcn.test_vcn ocid1.vcn.oc1..test\n") {
+		t.Errorf("Expected the import command of the VCN, got:\n%s", imports)
+	}
+
+	state, err := ioutil.ReadFile(filepath.Join(dir, "terraform.tfstate"))
+	if err != nil {
+		t.Fatalf("Unable to read the state: %v", err)
+	}
+	if !strings.Contains(string(state), `"oci_core_vcn.test_vcn"`) || !strings.Contains(string(state), `"default_security_list_id": "ocid1.securitylist.oc1..default"`) {
+		t.Errorf("Expected the VCN in the state, got:\n%s", state)
+	}
+}
+
+func TestUnitExportResourceHCL(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"subnet_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"rules": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ports": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeInt},
+					},
+				},
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"subnet_id":   "ocid1.subnet.oc1..test",
+		"description": "Uses \"quotes\" and ${interpolation}",
+		"enabled":     false,
+		"rules":       []interface{}{map[string]interface{}{"ports": []interface{}{22, 443}}},
+	})
+	d.Set("state", "AVAILABLE")
+
+	config := &bytes.Buffer{}
+	writeResourceHCL(config, "oci_test", "test", resourceSchema, d, map[string]string{"ocid1.subnet.oc1..test": "${oci_core_subnet.test.id}"})
+
+	expected := `resource "oci_test" "test" {
+  description = "Uses \"quotes\" and $${interpolation}"
+  enabled = false
+  rules {
+    ports = [22, 443]
+  }
+  subnet_id = "${oci_core_subnet.test.id}"
+}
+
+`
+	if config.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, config.String())
+	}
+}
+
+func TestUnitExportResourceName(t *testing.T) {
+	e := &resourceExporter{usedNames: map[string]bool{}}
+	vcnSchema := VcnResource().Schema
+
+	for _, testCase := range []struct{ displayName, expected string }{
+		{"Production VCN", "production_vcn"},
+		{"Production-VCN", "production_vcn_2"},
+		{"1st", "core_vcn_1st"},
+		{"", "core_vcn"},
+	} {
+		d := schema.TestResourceDataRaw(t, vcnSchema, map[string]interface{}{"display_name": testCase.displayName})
+		if name := e.resourceName("oci_core_vcn", d); name != testCase.expected {
+			t.Errorf("Expected the name '%s' for '%s', got '%s'", testCase.expected, testCase.displayName, name)
+		}
+	}
+}
@@ -37,7 +37,7 @@ func TestFileStorageExportResource_basic(t *testing.T) {
 	resourceName := "oci_file_storage_export.test_export"
 	datasourceName := "data.oci_file_storage_exports.test_exports"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -15,19 +15,24 @@ type SafeMutexMap struct {
 }
 
 // Given a load balancer ID and backend set name, finds a mutex. If a mutex doesn't exist, then create one for that backend set.
-//
-// We can add more generic ways of accessing this map in the future; if other resources need to use this pattern for
-// resolving concurrent resource access issues. For now, keep it specific to backend sets
 func (safeMap *SafeMutexMap) GetOrCreateBackendSetMutex(lbId string, backendSetName string) *sync.Mutex {
 	if lbId == "" || backendSetName == "" {
 		return nil
 	}
 
+	return safeMap.GetOrCreateMutex(fmt.Sprintf("%s.%s", lbId, backendSetName))
+}
+
+// GetOrCreateMutex finds the mutex for a key, such as the ID of the resource being modified. If a mutex doesn't exist,
+// then create one for that key.
+func (safeMap *SafeMutexMap) GetOrCreateMutex(key string) *sync.Mutex {
+	if key == "" {
+		return nil
+	}
+
 	safeMap.m.Lock()
 	defer safeMap.m.Unlock()
 
-	key := fmt.Sprintf("%s.%s", lbId, backendSetName)
-
 	if safeMap.mutexes == nil {
 		safeMap.mutexes = map[string]*sync.Mutex{}
 	}
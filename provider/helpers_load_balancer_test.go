@@ -71,3 +71,25 @@ func TestSafeMutexMap_ConcurrentGetOrCreateBackendSetMutex(t *testing.T) {
 		}
 	}
 }
+
+func TestSafeMutexMap_GetOrCreateMutex(t *testing.T) {
+	testMap := SafeMutexMap{}
+
+	mutex1 := testMap.GetOrCreateMutex("sgwocid1")
+	if mutex1 == nil {
+		t.Errorf("Did not get a mutex with a new key")
+		return
+	}
+
+	if mutex2 := testMap.GetOrCreateMutex("sgwocid1"); mutex2 != mutex1 {
+		t.Errorf("Expected an existing mutex, but got a new mutex with an existing key")
+	}
+
+	if mutex2 := testMap.GetOrCreateMutex("sgwocid2"); mutex2 == mutex1 {
+		t.Errorf("Expected a new mutex but got an existing mutex with a new key")
+	}
+
+	if mutex2 := testMap.GetOrCreateMutex(""); mutex2 != nil {
+		t.Errorf("Expected a nil mutex but got a valid one with an empty key")
+	}
+}
@@ -3,8 +3,36 @@
 package provider
 
 import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+)
+
+const (
+	bytesInMB = 1024 * 1024
+
+	// The service requires every part except the last one to be at least 10 MiB and at most 50 GiB
+	minMultipartPartSizeInMBs       = 10
+	maxMultipartPartSizeInMBs       = 50 * 1024
+	defaultMultipartPartSizeInMBs   = 128
+	defaultMultipartParallelUploads = 4
+	maxMultipartParallelUploads     = 32
+	maxMultipartParts               = 10000
 )
 
 func resourceObjectStorageMapToMetadata(rm map[string]interface{}) map[string]string {
@@ -39,3 +67,455 @@ func validateLowerCaseKeysInMetadata(raw interface{}, fieldName string) ([]strin
 
 	return nil, errors
 }
+
+// objectStorageMultipartUpload uploads a single object in parts of PartSize bytes. If any part fails, the whole
+// upload is aborted so that no uncommitted parts are left behind in the bucket.
+type objectStorageMultipartUpload struct {
+	Client          *oci_object_storage.ObjectStorageClient
+	NamespaceName   string
+	BucketName      string
+	ObjectName      string
+	ContentType     *string
+	ContentLanguage *string
+	ContentEncoding *string
+	Metadata        map[string]string
+	PartSize        int64
+	ParallelUploads int
+}
+
+type objectStorageUploadedPart struct {
+	PartNum int
+	ETag    *string
+	Err     error
+}
+
+func (u *objectStorageMultipartUpload) Upload(content io.ReaderAt, size int64) (err error) {
+	partCount := multipartPartCount(size, u.PartSize)
+	if partCount > maxMultipartParts {
+		return fmt.Errorf("object of %d bytes would be uploaded in %d parts, which is more than the %d allowed. Please increase 'multipart_part_size_in_mbs'", size, partCount, maxMultipartParts)
+	}
+
+	createRequest := oci_object_storage.CreateMultipartUploadRequest{}
+	createRequest.NamespaceName = &u.NamespaceName
+	createRequest.BucketName = &u.BucketName
+	createRequest.Object = &u.ObjectName
+	createRequest.ContentType = u.ContentType
+	createRequest.ContentLanguage = u.ContentLanguage
+	createRequest.ContentEncoding = u.ContentEncoding
+	createRequest.Metadata = u.Metadata
+	createRequest.RequestMetadata.RetryPolicy = getRetryPolicy(false, objectstorageService)
+
+	createResponse, err := u.Client.CreateMultipartUpload(context.Background(), createRequest)
+	if err != nil {
+		return err
+	}
+	uploadId := createResponse.UploadId
+
+	defer func() {
+		if err != nil {
+			if abortErr := u.abort(uploadId); abortErr != nil {
+				log.Printf("[WARN] Unable to abort multipart upload %s of object '%s'. Error: %q", *uploadId, u.ObjectName, abortErr)
+			}
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	partNums := make(chan int, partCount)
+	for partNum := 1; partNum <= partCount; partNum++ {
+		partNums <- partNum
+	}
+	close(partNums)
+
+	results := make(chan objectStorageUploadedPart, partCount)
+	wg := sync.WaitGroup{}
+	for i := 0; i < u.ParallelUploads && i < partCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for partNum := range partNums {
+				if ctx.Err() != nil {
+					return
+				}
+				etag, err := u.uploadPart(ctx, uploadId, content, size, partNum)
+				if err != nil {
+					cancel()
+				}
+				results <- objectStorageUploadedPart{PartNum: partNum, ETag: etag, Err: err}
+			}
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	partsToCommit := []oci_object_storage.CommitMultipartUploadPartDetails{}
+	for result := range results {
+		if result.Err != nil {
+			return fmt.Errorf("failed to upload part %d of object '%s': %v", result.PartNum, u.ObjectName, result.Err)
+		}
+		partNum := result.PartNum
+		partsToCommit = append(partsToCommit, oci_object_storage.CommitMultipartUploadPartDetails{PartNum: &partNum, Etag: result.ETag})
+	}
+	if len(partsToCommit) != partCount {
+		return fmt.Errorf("uploaded %d of %d parts of object '%s'", len(partsToCommit), partCount, u.ObjectName)
+	}
+	sort.Slice(partsToCommit, func(i, j int) bool {
+		return *partsToCommit[i].PartNum < *partsToCommit[j].PartNum
+	})
+
+	commitRequest := oci_object_storage.CommitMultipartUploadRequest{}
+	commitRequest.NamespaceName = &u.NamespaceName
+	commitRequest.BucketName = &u.BucketName
+	commitRequest.ObjectName = &u.ObjectName
+	commitRequest.UploadId = uploadId
+	commitRequest.PartsToCommit = partsToCommit
+	commitRequest.RequestMetadata.RetryPolicy = getRetryPolicy(false, objectstorageService)
+
+	_, err = u.Client.CommitMultipartUpload(context.Background(), commitRequest)
+	return err
+}
+
+// uploadPart retries on its own rather than through a RetryPolicy, since the part body has to be re-read from the
+// start for every attempt.
+func (u *objectStorageMultipartUpload) uploadPart(ctx context.Context, uploadId *string, content io.ReaderAt, size int64, partNum int) (*string, error) {
+	offset := int64(partNum-1) * u.PartSize
+	partSize := u.PartSize
+	if offset+partSize > size {
+		partSize = size - offset
+	}
+	contentLength := int(partSize)
+
+	request := oci_object_storage.UploadPartRequest{}
+	request.NamespaceName = &u.NamespaceName
+	request.BucketName = &u.BucketName
+	request.ObjectName = &u.ObjectName
+	request.UploadId = uploadId
+	request.UploadPartNum = &partNum
+	request.ContentLength = &contentLength
+
+	for attempt := uint(1); ; attempt++ {
+		request.UploadPartBody = ioutil.NopCloser(io.NewSectionReader(content, offset, partSize))
+
+		response, err := u.Client.UploadPart(ctx, request)
+		if err == nil {
+			return response.ETag, nil
+		}
+
+		operationResponse := oci_common.NewOCIOperationResponse(response, err, attempt)
+		if ctx.Err() != nil || !shouldRetry(operationResponse, false, objectstorageService) {
+			return nil, err
+		}
+		time.Sleep(nextDuration(operationResponse))
+	}
+}
+
+func (u *objectStorageMultipartUpload) abort(uploadId *string) error {
+	return abortMultipartUpload(u.Client, u.NamespaceName, u.BucketName, u.ObjectName, *uploadId)
+}
+
+func abortMultipartUpload(client *oci_object_storage.ObjectStorageClient, namespaceName string, bucketName string, objectName string, uploadId string) error {
+	request := oci_object_storage.AbortMultipartUploadRequest{}
+	request.NamespaceName = &namespaceName
+	request.BucketName = &bucketName
+	request.ObjectName = &objectName
+	request.UploadId = &uploadId
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(true, objectstorageService)
+
+	_, err := client.AbortMultipartUpload(context.Background(), request)
+	return err
+}
+
+func listMultipartUploads(client *oci_object_storage.ObjectStorageClient, namespaceName string, bucketName string) ([]oci_object_storage.MultipartUpload, error) {
+	request := oci_object_storage.ListMultipartUploadsRequest{}
+	request.NamespaceName = &namespaceName
+	request.BucketName = &bucketName
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, objectstorageService)
+
+	uploads := []oci_object_storage.MultipartUpload{}
+	for {
+		response, err := client.ListMultipartUploads(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+
+		uploads = append(uploads, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+
+	return uploads, nil
+}
+
+// putObjectFromFile uploads a file of the given size into the object of the request. Files larger than partSize are
+// uploaded in parts, parallelUploads at a time. Each attempt re-reads the file from the start, so the upload is
+// retried on its own rather than through a RetryPolicy.
+func putObjectFromFile(client *oci_object_storage.ObjectStorageClient, request oci_object_storage.PutObjectRequest, file *os.File, size int64, partSize int64, parallelUploads int) error {
+	if size > partSize {
+		multipartUpload := &objectStorageMultipartUpload{
+			Client:          client,
+			NamespaceName:   *request.NamespaceName,
+			BucketName:      *request.BucketName,
+			ObjectName:      *request.ObjectName,
+			ContentType:     request.ContentType,
+			ContentLanguage: request.ContentLanguage,
+			ContentEncoding: request.ContentEncoding,
+			Metadata:        request.OpcMeta,
+			PartSize:        partSize,
+			ParallelUploads: parallelUploads,
+		}
+
+		return multipartUpload.Upload(file, size)
+	}
+
+	contentLength := int(size)
+	request.ContentLength = &contentLength
+
+	for attempt := uint(1); ; attempt++ {
+		request.PutObjectBody = ioutil.NopCloser(io.NewSectionReader(file, 0, size))
+
+		response, err := client.PutObject(context.Background(), request)
+		if err == nil {
+			return nil
+		}
+
+		operationResponse := oci_common.NewOCIOperationResponse(response, err, attempt)
+		if !shouldRetry(operationResponse, false, objectstorageService) {
+			return err
+		}
+		time.Sleep(nextDuration(operationResponse))
+	}
+}
+
+// listAllObjects lists the name, size and MD5 of every object in a bucket whose name starts with prefix.
+func listAllObjects(client *oci_object_storage.ObjectStorageClient, namespaceName string, bucketName string, prefix string) ([]oci_object_storage.ObjectSummary, error) {
+	request := oci_object_storage.ListObjectsRequest{
+		Fields: &listObjectsFields,
+	}
+	request.NamespaceName = &namespaceName
+	request.BucketName = &bucketName
+	if prefix != "" {
+		request.Prefix = &prefix
+	}
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, objectstorageService)
+
+	objects := []oci_object_storage.ObjectSummary{}
+	for {
+		response, err := client.ListObjects(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, response.Objects...)
+		if response.NextStartWith == nil || *response.NextStartWith == "" {
+			break
+		}
+		request.Start = response.NextStartWith
+	}
+
+	return objects, nil
+}
+
+// forEachObjectInParallel calls fn for every object name, parallelism calls at a time. A failed call doesn't stop the
+// others; the failures are returned together once all the calls are done.
+func forEachObjectInParallel(objectNames []string, parallelism int, fn func(objectName string) error) error {
+	names := make(chan string, len(objectNames))
+	for _, objectName := range objectNames {
+		names <- objectName
+	}
+	close(names)
+
+	mutex := sync.Mutex{}
+	failures := []string{}
+	wg := sync.WaitGroup{}
+	for i := 0; i < parallelism && i < len(objectNames); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for objectName := range names {
+				if err := fn(objectName); err != nil {
+					mutex.Lock()
+					failures = append(failures, fmt.Sprintf("'%s': %v", objectName, err))
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(failures) > 0 {
+		sort.Strings(failures)
+		return fmt.Errorf("%d of %d objects failed:\n%s", len(failures), len(objectNames), strings.Join(failures, "\n"))
+	}
+	return nil
+}
+
+// listObjectSetFiles walks sourceDir and returns the object name of every file to upload, mapped to the path of the
+// file. Object names are the prefix followed by the '/' separated path of the file relative to sourceDir.
+//
+// A file is uploaded if it matches any of the include patterns, or if there are none, and it matches none of the
+// exclude patterns. Patterns use the syntax of path.Match. Patterns without a '/' are matched against the name of the
+// file, so that '*.html' matches HTML files in every directory; other patterns are matched against the relative path.
+func listObjectSetFiles(sourceDir string, prefix string, include []string, exclude []string) (map[string]string, error) {
+	files := map[string]string{}
+	err := filepath.Walk(sourceDir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(sourceDir, filePath)
+		if err != nil {
+			return err
+		}
+		relativePath = filepath.ToSlash(relativePath)
+
+		if !objectSetIncludes(relativePath, include, exclude) {
+			return nil
+		}
+
+		files[prefix+relativePath] = filePath
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// objectSetIncludes reports whether the include and exclude patterns select a file, or an object, by its path relative to
+// the source directory, or to the prefix.
+func objectSetIncludes(relativePath string, include []string, exclude []string) bool {
+	if len(include) > 0 && !matchesObjectSetPattern(include, relativePath) {
+		return false
+	}
+	return !matchesObjectSetPattern(exclude, relativePath)
+}
+
+func matchesObjectSetPattern(patterns []string, relativePath string) bool {
+	for _, pattern := range patterns {
+		name := relativePath
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(relativePath)
+		}
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// fileMatchesMd5 compares a local file with the MD5 of an object, which is a multipart MD5 for objects that were
+// uploaded in parts of partSize bytes.
+func fileMatchesMd5(filePath string, remoteMd5 string, partSize int64) (bool, error) {
+	var localMd5 string
+	var err error
+	if strings.Contains(remoteMd5, "-") {
+		localMd5, err = multipartMd5OfFile(filePath, partSize)
+	} else {
+		localMd5, err = md5OfFile(filePath)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return localMd5 == remoteMd5, nil
+}
+
+func multipartPartCount(size int64, partSize int64) int {
+	if size == 0 {
+		return 1
+	}
+	return int((size + partSize - 1) / partSize)
+}
+
+// md5OfFile returns the base64 encoded MD5 of a file, in the same format as the 'content-md5' the service returns.
+func md5OfFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(hash.Sum(nil)), nil
+}
+
+// multipartMd5OfFile returns the MD5 the service reports in 'opc-multipart-md5' for a file uploaded in parts of
+// partSize bytes: the base64 encoded MD5 of the concatenated part MD5s, followed by "-<number of parts>".
+func multipartMd5OfFile(path string, partSize int64) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	partHashes := md5.New()
+	partCount := 0
+	for {
+		hash := md5.New()
+		written, err := io.CopyN(hash, file, partSize)
+		if err != nil && err != io.EOF {
+			return "", err
+		}
+		if written == 0 && partCount > 0 {
+			break
+		}
+		partHashes.Write(hash.Sum(nil))
+		partCount++
+		if err == io.EOF {
+			break
+		}
+	}
+
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(partHashes.Sum(nil)), partCount), nil
+}
+
+// multipartMd5PartCount returns the part count suffix of a multipart MD5, such as '3' for 'xxxx-3'.
+func multipartMd5PartCount(multipartMd5 string) string {
+	return multipartMd5[strings.LastIndex(multipartMd5, "-")+1:]
+}
+
+// parseObjectStorageUri extracts the namespace, bucket and object names from an Object Storage URI, such as
+// https://objectstorage.us-phoenix-1.oraclecloud.com/n/<namespace>/b/<bucket>/o/<object>, or the equivalent
+// pre-authenticated request URI with a /p/<token> prefix.
+func parseObjectStorageUri(uri string) (namespaceName string, bucketName string, objectName string, err error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	path := parsed.EscapedPath()
+	if strings.HasPrefix(path, "/p/") {
+		// Drop the pre-authenticated request token
+		if i := strings.Index(path[len("/p/"):], "/"); i >= 0 {
+			path = path[len("/p/")+i:]
+		}
+	}
+
+	// Object names may contain '/', so only split off the namespace and bucket
+	parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 6)
+	if len(parts) != 6 || parts[0] != "n" || parts[2] != "b" || parts[4] != "o" || parts[5] == "" {
+		return "", "", "", fmt.Errorf("'%s' is not an Object Storage object URI of the form .../n/<namespace>/b/<bucket>/o/<object>", uri)
+	}
+
+	if namespaceName, err = url.PathUnescape(parts[1]); err != nil {
+		return "", "", "", err
+	}
+	if bucketName, err = url.PathUnescape(parts[3]); err != nil {
+		return "", "", "", err
+	}
+	if objectName, err = url.PathUnescape(parts[5]); err != nil {
+		return "", "", "", err
+	}
+
+	return namespaceName, bucketName, objectName, nil
+}
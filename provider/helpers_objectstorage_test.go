@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func writeTempFile(t *testing.T, content []byte) string {
+	file, err := ioutil.TempFile("", "tf-objectstorage-helpers")
+	if err != nil {
+		t.Fatalf("Unable to create temp file. Error: %q", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(content); err != nil {
+		t.Fatalf("Unable to write temp file. Error: %q", err)
+	}
+	return file.Name()
+}
+
+func TestMd5OfFile(t *testing.T) {
+	content := []byte("content")
+	path := writeTempFile(t, content)
+	defer os.Remove(path)
+
+	sum := md5.Sum(content)
+	expected := base64.StdEncoding.EncodeToString(sum[:])
+
+	actual, err := md5OfFile(path)
+	if err != nil {
+		t.Errorf("Unexpected error: %q", err)
+		return
+	}
+	if actual != expected {
+		t.Errorf("Expected MD5 '%s' but got '%s'", expected, actual)
+	}
+}
+
+func TestMultipartMd5OfFile(t *testing.T) {
+	content := []byte("0123456789abcdefghij")
+
+	testCases := []struct {
+		partSize int64
+		parts    [][]byte
+	}{
+		{partSize: 8, parts: [][]byte{content[0:8], content[8:16], content[16:20]}},
+		{partSize: 10, parts: [][]byte{content[0:10], content[10:20]}},
+		{partSize: 20, parts: [][]byte{content}},
+		{partSize: 100, parts: [][]byte{content}},
+	}
+
+	path := writeTempFile(t, content)
+	defer os.Remove(path)
+
+	for _, testCase := range testCases {
+		partHashes := []byte{}
+		for _, part := range testCase.parts {
+			sum := md5.Sum(part)
+			partHashes = append(partHashes, sum[:]...)
+		}
+		sum := md5.Sum(partHashes)
+		expected := fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(sum[:]), len(testCase.parts))
+
+		actual, err := multipartMd5OfFile(path, testCase.partSize)
+		if err != nil {
+			t.Errorf("Unexpected error with part size %d: %q", testCase.partSize, err)
+			continue
+		}
+		if actual != expected {
+			t.Errorf("Expected multipart MD5 '%s' with part size %d but got '%s'", expected, testCase.partSize, actual)
+		}
+		if count := multipartMd5PartCount(actual); count != fmt.Sprint(len(testCase.parts)) {
+			t.Errorf("Expected the part count %d in '%s' but got '%s'", len(testCase.parts), actual, count)
+		}
+		if count := multipartPartCount(int64(len(content)), testCase.partSize); count != len(testCase.parts) {
+			t.Errorf("Expected %d parts with part size %d but got %d", len(testCase.parts), testCase.partSize, count)
+		}
+	}
+}
+
+func TestParseObjectStorageUri(t *testing.T) {
+	tests := []struct {
+		uri       string
+		namespace string
+		bucket    string
+		object    string
+		wantErr   bool
+	}{
+		{
+			uri:       "https://objectstorage.us-phoenix-1.oraclecloud.com/n/mytenancy/b/images/o/golden.oci",
+			namespace: "mytenancy", bucket: "images", object: "golden.oci",
+		},
+		{
+			uri:       "https://objectstorage.us-phoenix-1.oraclecloud.com/p/a1b2-c3_d4/n/mytenancy/b/images/o/exports/golden%20image.oci",
+			namespace: "mytenancy", bucket: "images", object: "exports/golden image.oci",
+		},
+		{
+			uri:     "https://objectstorage.us-phoenix-1.oraclecloud.com/n/mytenancy/b/images/",
+			wantErr: true,
+		},
+		{
+			uri:     "https://objectstorage.us-phoenix-1.oraclecloud.com/n/mytenancy/b/images/o/",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		namespace, bucket, object, err := parseObjectStorageUri(test.uri)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("Expected an error for '%s'", test.uri)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error for '%s': %q", test.uri, err)
+			continue
+		}
+		if namespace != test.namespace || bucket != test.bucket || object != test.object {
+			t.Errorf("Parsing '%s' returned (%s, %s, %s), expected (%s, %s, %s)", test.uri, namespace, bucket, object, test.namespace, test.bucket, test.object)
+		}
+	}
+}
+
+func TestListObjectSetFiles(t *testing.T) {
+	sourceDir, err := ioutil.TempDir("", "tf-objectstorage-object-set")
+	if err != nil {
+		t.Fatalf("Unable to create temp dir. Error: %q", err)
+	}
+	defer os.RemoveAll(sourceDir)
+
+	for _, relativePath := range []string{"index.html", "robots.txt", "css/site.css", "docs/guide.html", "docs/draft/notes.txt"} {
+		filePath := filepath.Join(sourceDir, filepath.FromSlash(relativePath))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatalf("Unable to create dir. Error: %q", err)
+		}
+		if err := ioutil.WriteFile(filePath, []byte(relativePath), 0644); err != nil {
+			t.Fatalf("Unable to write file. Error: %q", err)
+		}
+	}
+
+	tests := []struct {
+		prefix   string
+		include  []string
+		exclude  []string
+		expected []string
+	}{
+		{
+			expected: []string{"css/site.css", "docs/draft/notes.txt", "docs/guide.html", "index.html", "robots.txt"},
+		},
+		{
+			prefix:   "site/",
+			include:  []string{"*.html"},
+			expected: []string{"site/docs/guide.html", "site/index.html"},
+		},
+		{
+			exclude:  []string{"docs/draft/*", "*.css"},
+			expected: []string{"docs/guide.html", "index.html", "robots.txt"},
+		},
+		{
+			include:  []string{"docs/*", "*.txt"},
+			exclude:  []string{"robots.txt"},
+			expected: []string{"docs/draft/notes.txt", "docs/guide.html"},
+		},
+	}
+
+	for _, test := range tests {
+		files, err := listObjectSetFiles(sourceDir, test.prefix, test.include, test.exclude)
+		if err != nil {
+			t.Errorf("Unexpected error: %q", err)
+			continue
+		}
+
+		objectNames := []string{}
+		for objectName, filePath := range files {
+			objectNames = append(objectNames, objectName)
+			if _, err := os.Stat(filePath); err != nil {
+				t.Errorf("Object '%s' mapped to a missing file %s", objectName, filePath)
+			}
+		}
+		sort.Strings(objectNames)
+
+		if !reflect.DeepEqual(objectNames, test.expected) {
+			t.Errorf("Listing with prefix '%s', include %v and exclude %v returned %v, expected %v", test.prefix, test.include, test.exclude, objectNames, test.expected)
+		}
+	}
+
+	if _, err := listObjectSetFiles(filepath.Join(sourceDir, "missing"), "", nil, nil); err == nil {
+		t.Errorf("Expected an error listing a missing directory")
+	}
+}
+
+func TestForEachObjectInParallel(t *testing.T) {
+	objectNames := []string{"a", "b", "c", "d", "e"}
+
+	mutex := sync.Mutex{}
+	called := []string{}
+	err := forEachObjectInParallel(objectNames, 2, func(objectName string) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		called = append(called, objectName)
+		if objectName == "b" || objectName == "d" {
+			return fmt.Errorf("failed")
+		}
+		return nil
+	})
+
+	sort.Strings(called)
+	if !reflect.DeepEqual(called, objectNames) {
+		t.Errorf("Expected every object to be processed despite failures, got %v", called)
+	}
+	if err == nil || err.Error() != "2 of 5 objects failed:\n'b': failed\n'd': failed" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if err := forEachObjectInParallel([]string{}, 2, nil); err != nil {
+		t.Errorf("Unexpected error for no objects: %q", err)
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+const (
+	// httpModeEnvironmentVariable selects whether the acceptance tests record their requests to fixtures, or replay the
+	// responses of the fixtures without sending the requests.
+	httpModeEnvironmentVariable = "OCI_TEST_HTTP_MODE"
+	httpModeRecord              = "record"
+	httpModeReplay              = "replay"
+)
+
+// Response headers that are not recorded, in addition to the redactedHeaders.
+var unrecordedHeaders = map[string]bool{
+	"content-length": true,
+	"date":           true,
+}
+
+// objectContentPath matches the paths of the content of Object Storage objects, but not of the listing of a bucket.
+var objectContentPath = regexp.MustCompile(`/n/[^/]+/b/[^/]+/o/.`)
+
+// httpInteraction is a request and its response, as saved to a fixture file. The headers of the request, including its
+// signature, are not recorded.
+type httpInteraction struct {
+	Method string `json:"method"`
+	// The path and query of the request. The fixtures are replayed in the region they were recorded in, so the host is
+	// not matched.
+	URL         string      `json:"url"`
+	RequestBody interface{} `json:"request_body,omitempty"`
+
+	Status          int               `json:"status"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	ResponseBody    interface{}       `json:"response_body,omitempty"`
+	// Bodies that are neither JSON nor text, such as the content of some objects
+	ResponseBodyBase64 string `json:"response_body_base64,omitempty"`
+}
+
+// httpFixture is the fixture file of a test.
+type httpFixture struct {
+	Region       string            `json:"region"`
+	Interactions []httpInteraction `json:"interactions"`
+}
+
+// httpCassette records the requests of the clients of a provider to a fixture, or replays the responses of a fixture.
+// Requests are replayed by method and URL, in the order they were recorded, so that polling a resource returns its
+// successive states. Once they are all replayed, the last response is repeated.
+type httpCassette struct {
+	lock      sync.Mutex
+	replaying bool
+	fixture   httpFixture
+	// The indexes of the interactions of each method and URL, and the number of them that were replayed
+	interactions map[string][]int
+	replayed     map[string]int
+}
+
+func newRecordingHTTPCassette(region string) *httpCassette {
+	return &httpCassette{fixture: httpFixture{Region: region, Interactions: []httpInteraction{}}}
+}
+
+func loadHTTPCassette(path string) (*httpCassette, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cassette := &httpCassette{replaying: true, interactions: map[string][]int{}, replayed: map[string]int{}}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	if err := decoder.Decode(&cassette.fixture); err != nil {
+		return nil, fmt.Errorf("can not read the HTTP fixture: '%s', Error: %q", path, err)
+	}
+
+	for i, interaction := range cassette.fixture.Interactions {
+		key := interaction.Method + " " + interaction.URL
+		cassette.interactions[key] = append(cassette.interactions[key], i)
+	}
+	return cassette, nil
+}
+
+// dispatcher returns a dispatcher that records the requests sent with the given dispatcher, or that replays them.
+func (c *httpCassette) dispatcher(httpClient oci_common.HTTPRequestDispatcher) oci_common.HTTPRequestDispatcher {
+	if c.replaying {
+		return replayingDispatcher{cassette: c}
+	}
+	return recordingDispatcher{HTTPRequestDispatcher: httpClient, cassette: c}
+}
+
+func (c *httpCassette) record(interaction httpInteraction) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.fixture.Interactions = append(c.fixture.Interactions, interaction)
+}
+
+func (c *httpCassette) replay(request *http.Request) (httpInteraction, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := request.Method + " " + request.URL.RequestURI()
+	indexes := c.interactions[key]
+	if len(indexes) == 0 {
+		return httpInteraction{}, false
+	}
+
+	replayed := c.replayed[key]
+	if replayed < len(indexes) {
+		c.replayed[key]++
+	} else {
+		replayed = len(indexes) - 1
+	}
+	return c.fixture.Interactions[indexes[replayed]], true
+}
+
+// save writes the recorded requests to a fixture file, replacing each of the keys of substitutions, such as the OCIDs of
+// the tenancy, with its value.
+func (c *httpCassette) save(path string, substitutions map[string]string) error {
+	c.lock.Lock()
+	content, err := json.MarshalIndent(c.fixture, "", "  ")
+	c.lock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	// Longer values are replaced first, in case they contain shorter ones
+	values := []string{}
+	for value := range substitutions {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool { return len(values[i]) > len(values[j]) })
+	for _, value := range values {
+		content = bytes.Replace(content, []byte(value), []byte(substitutions[value]), -1)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(content, '\n'), 0644)
+}
+
+// recordingDispatcher records the requests of the clients of a provider, and their responses.
+type recordingDispatcher struct {
+	oci_common.HTTPRequestDispatcher
+	cassette *httpCassette
+}
+
+func (d recordingDispatcher) Do(request *http.Request) (*http.Response, error) {
+	interaction := httpInteraction{Method: request.Method, URL: request.URL.RequestURI()}
+	if request.Body != nil {
+		interaction.RequestBody, request.Body = traceBody(request.URL.Path, request.Header, request.ContentLength, request.Body)
+	}
+
+	response, err := d.HTTPRequestDispatcher.Do(request)
+	if err != nil {
+		return response, err
+	}
+
+	content, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(content))
+
+	interaction.Status = response.StatusCode
+	interaction.ResponseHeaders = map[string]string{}
+	for name, values := range response.Header {
+		if !redactedHeaders[strings.ToLower(name)] && !unrecordedHeaders[strings.ToLower(name)] {
+			interaction.ResponseHeaders[name] = strings.Join(values, ", ")
+		}
+	}
+	interaction.ResponseBody, interaction.ResponseBodyBase64 = recordedBody(request.URL.Path, response.Header, content)
+
+	d.cassette.record(interaction)
+	return response, nil
+}
+
+// recordedBody returns the redacted JSON of a body of a response to a request to the given path, or its text, or else
+// its base64 encoding. Bodies that are secret as a whole, and text that contains a PEM private key, are redacted. The
+// content of objects, which can be large or private, is replaced with its MD5, so tests that compare it with what
+// they uploaded can't be replayed.
+func recordedBody(path string, header http.Header, content []byte) (interface{}, string) {
+	if len(content) == 0 {
+		return nil, ""
+	}
+	if isRedactedBody(path) {
+		return redactedValue, ""
+	}
+	if objectContentPath.MatchString(path) {
+		sum := md5.Sum(content)
+		return fmt.Sprintf("[OBJECT CONTENT md5:%s]", base64.StdEncoding.EncodeToString(sum[:])), ""
+	}
+
+	if strings.Contains(header.Get("Content-Type"), "json") {
+		var value interface{}
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.UseNumber()
+		if err := decoder.Decode(&value); err == nil {
+			return redactJSON(path, value), ""
+		}
+	} else if utf8.Valid(content) {
+		return redactJSON(path, string(content)), ""
+	}
+	return nil, base64.StdEncoding.EncodeToString(content)
+}
+
+// replayingDispatcher answers the requests of the clients of a provider with the responses of a fixture, without
+// sending them.
+type replayingDispatcher struct {
+	cassette *httpCassette
+}
+
+func (d replayingDispatcher) Do(request *http.Request) (*http.Response, error) {
+	interaction, ok := d.cassette.replay(request)
+	if !ok {
+		return nil, fmt.Errorf("no recorded response for %s %s", request.Method, request.URL.RequestURI())
+	}
+
+	header := http.Header{}
+	for name, value := range interaction.ResponseHeaders {
+		header.Set(name, value)
+	}
+
+	var content []byte
+	var err error
+	switch {
+	case interaction.ResponseBodyBase64 != "":
+		content, err = base64.StdEncoding.DecodeString(interaction.ResponseBodyBase64)
+	case interaction.ResponseBody == nil:
+	case strings.Contains(header.Get("Content-Type"), "json"):
+		content, err = json.Marshal(interaction.ResponseBody)
+	default:
+		content = []byte(fmt.Sprint(interaction.ResponseBody))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("can not replay the response of %s %s, Error: %q", request.Method, request.URL.RequestURI(), err)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Status, http.StatusText(interaction.Status)),
+		StatusCode:    interaction.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(content)),
+		ContentLength: int64(len(content)),
+		Request:       request,
+	}, nil
+}
@@ -0,0 +1,319 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+)
+
+const (
+	// Larger bodies, such as Object Storage objects, are not traced
+	maxTracedBodyLength = 64 * 1024
+	redactedValue       = "[REDACTED]"
+)
+
+// redactedHeaders are the headers whose values are never traced.
+var redactedHeaders = map[string]bool{
+	"authorization":       true,
+	"proxy-authorization": true,
+	"opc-obo-token":       true,
+	"cookie":              true,
+	"set-cookie":          true,
+}
+
+// redactedFieldSubstrings match the JSON fields whose values are never traced, once the fields are lowercased and their
+// '_' and '-' removed, such as 'adminPassword', 'chap_secret', 'passphrase' or 'private_key'. The 'accessUri' of a
+// pre-authenticated request and the 'destinationUri' of an image export grant access to objects without signing.
+var redactedFieldSubstrings = []string{"password", "passphrase", "secret", "privatekey", "token", "accessuri", "destinationuri"}
+
+// preauthenticatedRequestPath matches the token of a pre-authenticated request in its URL or path, such as
+// '/p/<token>/n/<namespace>/b/<bucket>/o/<object>'.
+var preauthenticatedRequestPath = regexp.MustCompile(`/p/[^/]+/n/`)
+
+// redactedPathFields are the JSON fields whose values are never traced in the bodies of the requests to a path with the
+// given segment, because their names don't tell that they are secret, such as the 'key' of a Customer Secret Key.
+var redactedPathFields = map[string][]string{
+	"customerSecretKeys": {"key"},
+}
+
+// redactedBodyPathSegments are the segments of the paths whose response bodies are never traced, because they are
+// secret as a whole, such as the content of a kubeconfig.
+var redactedBodyPathSegments = []string{"kubeconfig"}
+
+// httpTraceEntry is a traced request, as written to the JSON-lines file.
+type httpTraceEntry struct {
+	Time            string            `json:"time"`
+	Method          string            `json:"method"`
+	URL             string            `json:"url"`
+	Status          int               `json:"status,omitempty"`
+	OpcRequestId    string            `json:"opc_request_id,omitempty"`
+	Attempt         uint              `json:"attempt"`
+	DurationMs      int64             `json:"duration_ms"`
+	Error           string            `json:"error,omitempty"`
+	RequestHeaders  map[string]string `json:"request_headers,omitempty"`
+	RequestBody     interface{}       `json:"request_body,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	ResponseBody    interface{}       `json:"response_body,omitempty"`
+}
+
+// httpTracer traces the requests of the clients of a provider to the Terraform log, or to a JSON-lines file.
+type httpTracer struct {
+	lock sync.Mutex
+	// nil to trace to the Terraform log, an httpTraceFile to trace to a file
+	file io.Writer
+	// The attempt number of the next request with the given method and URL, recorded when the retry policy decides to
+	// retry a request. The SDK builds a new request for each attempt, so they are matched by method and URL.
+	pendingRetries map[string]uint
+}
+
+func newHTTPTracer(file io.Writer) *httpTracer {
+	return &httpTracer{file: file, pendingRetries: map[string]uint{}}
+}
+
+// httpTraceFile is the path of a JSON-lines trace file. The file is opened to append each entry and closed after it, so
+// that no handle is left open however many times the provider is configured.
+type httpTraceFile string
+
+func (f httpTraceFile) Write(line []byte) (int, error) {
+	file, err := os.OpenFile(string(f), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := file.Write(line)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return n, err
+}
+
+// httpTracerFromProvider returns the tracer of the provider block, or nil if tracing is disabled.
+func httpTracerFromProvider(d *schema.ResourceData) (*httpTracer, error) {
+	path, hasPath := d.GetOk("http_trace_file")
+	if !hasPath {
+		if !d.Get("http_trace").(bool) {
+			return nil, nil
+		}
+		return newHTTPTracer(nil), nil
+	}
+
+	// Open the file once, so that a path that can't be written is reported when the provider is configured
+	file := httpTraceFile(path.(string))
+	if _, err := file.Write(nil); err != nil {
+		return nil, fmt.Errorf("can not open the HTTP trace file: '%s', Error: %q", path, err)
+	}
+	return newHTTPTracer(file), nil
+}
+
+func traceKey(request *http.Request) string {
+	return request.Method + " " + request.URL.String()
+}
+
+// retrying records that the request of the response is about to be retried.
+func (t *httpTracer) retrying(response oci_common.OCIOperationResponse) {
+	if response.Response == nil || response.Response.HTTPResponse() == nil || response.Response.HTTPResponse().Request == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.pendingRetries[traceKey(response.Response.HTTPResponse().Request)] = response.AttemptNumber + 1
+}
+
+func (t *httpTracer) attempt(request *http.Request) uint {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	key := traceKey(request)
+	if attempt, ok := t.pendingRetries[key]; ok {
+		delete(t.pendingRetries, key)
+		return attempt
+	}
+	return 1
+}
+
+func (t *httpTracer) write(entry httpTraceEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[WARN] Unable to trace the request %s %s: %v", entry.Method, entry.URL, err)
+		return
+	}
+
+	if t.file == nil {
+		log.Printf("[INFO] OCI HTTP trace: %s", line)
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if _, err := t.file.Write(append(line, '\n')); err != nil {
+		log.Printf("[WARN] Unable to write the HTTP trace file: %v", err)
+	}
+}
+
+type httpTracerContextKey struct{}
+
+// tracingDispatcher traces the requests of the clients of a provider. It is wrapped by the retrySettingsDispatcher of
+// the provider and resources, and attaches the tracer to the requests so that the retry policy can find it.
+type tracingDispatcher struct {
+	oci_common.HTTPRequestDispatcher
+	tracer *httpTracer
+}
+
+func (d tracingDispatcher) Do(request *http.Request) (*http.Response, error) {
+	request = request.WithContext(context.WithValue(request.Context(), httpTracerContextKey{}, d.tracer))
+
+	entry := httpTraceEntry{
+		Time:           time.Now().UTC().Format(time.RFC3339Nano),
+		Method:         request.Method,
+		URL:            redactPreauthenticatedRequests(request.URL.String()),
+		Attempt:        d.tracer.attempt(request),
+		RequestHeaders: redactHeaders(request.Header),
+	}
+	if request.Body != nil {
+		entry.RequestBody, request.Body = traceBody(request.URL.Path, request.Header, request.ContentLength, request.Body)
+	}
+
+	start := time.Now()
+	response, err := d.HTTPRequestDispatcher.Do(request)
+	entry.DurationMs = int64(time.Since(start) / time.Millisecond)
+
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	if response != nil {
+		entry.Status = response.StatusCode
+		entry.OpcRequestId = response.Header.Get("opc-request-id")
+		entry.ResponseHeaders = redactHeaders(response.Header)
+		if response.Body != nil {
+			if isRedactedBody(request.URL.Path) {
+				entry.ResponseBody = redactedValue
+			} else {
+				entry.ResponseBody, response.Body = traceBody(request.URL.Path, response.Header, response.ContentLength, response.Body)
+			}
+		}
+	}
+
+	d.tracer.write(entry)
+	return response, err
+}
+
+// traceRetry records the retry of a request, if it was made by clients that trace their requests.
+func traceRetry(response oci_common.OCIOperationResponse) {
+	if response.Response == nil || response.Response.HTTPResponse() == nil || response.Response.HTTPResponse().Request == nil {
+		return
+	}
+	if tracer, ok := response.Response.HTTPResponse().Request.Context().Value(httpTracerContextKey{}).(*httpTracer); ok {
+		tracer.retrying(response)
+	}
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	result := map[string]string{}
+	for name, values := range header {
+		if redactedHeaders[strings.ToLower(name)] {
+			result[name] = redactedValue
+		} else {
+			result[name] = strings.Join(values, ", ")
+		}
+	}
+	return result
+}
+
+// traceBody returns the redacted JSON of a body of a request to the given path, and a body that replaces the one that
+// was read. Bodies that are not JSON, or that are too large, are left unread.
+func traceBody(path string, header http.Header, contentLength int64, body io.ReadCloser) (interface{}, io.ReadCloser) {
+	if !strings.Contains(header.Get("Content-Type"), "json") || contentLength < 0 || contentLength > maxTracedBodyLength {
+		return nil, body
+	}
+
+	content, err := ioutil.ReadAll(body)
+	body.Close()
+	replacement := ioutil.NopCloser(bytes.NewReader(content))
+	if err != nil {
+		return fmt.Sprintf("unable to read the body: %v", err), replacement
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(content, &value); err != nil {
+		return nil, replacement
+	}
+	return redactJSON(path, value), replacement
+}
+
+// redactJSON redacts the values of the sensitive fields of a body of a request to the given path, any PEM private key,
+// and the tokens of pre-authenticated requests.
+func redactJSON(path string, value interface{}) interface{} {
+	pathFields := map[string]bool{}
+	for _, segment := range strings.Split(path, "/") {
+		for _, field := range redactedPathFields[segment] {
+			pathFields[field] = true
+		}
+	}
+	return redactJSONFields(value, pathFields)
+}
+
+func redactJSONFields(value interface{}, pathFields map[string]bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for field, fieldValue := range v {
+			if pathFields[field] || isRedactedField(field) {
+				v[field] = redactedValue
+			} else {
+				v[field] = redactJSONFields(fieldValue, pathFields)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactJSONFields(item, pathFields)
+		}
+	case string:
+		if strings.Contains(v, "PRIVATE KEY-----") {
+			return redactedValue
+		}
+		return redactPreauthenticatedRequests(v)
+	}
+	return value
+}
+
+// redactPreauthenticatedRequests redacts the tokens of the pre-authenticated request URLs or paths in a string.
+func redactPreauthenticatedRequests(value string) string {
+	return preauthenticatedRequestPath.ReplaceAllString(value, "/p/"+redactedValue+"/n/")
+}
+
+func isRedactedField(field string) bool {
+	normalized := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(field))
+	for _, substring := range redactedFieldSubstrings {
+		if strings.Contains(normalized, substring) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRedactedBody returns whether the response bodies of the requests to the given path are secret as a whole.
+func isRedactedBody(path string) bool {
+	for _, segment := range strings.Split(path, "/") {
+		for _, redactedSegment := range redactedBodyPathSegments {
+			if segment == redactedSegment {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -67,7 +67,7 @@ EOF
 EOF
 `,
 	}
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// Verify construct resource
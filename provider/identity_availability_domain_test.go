@@ -36,7 +36,7 @@ func TestIdentityAvailabilityDomainResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_identity_availability_domains.test_availability_domains"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -31,7 +31,7 @@ func (s *ResourceIdentityGroupTestSuite) SetupTest() {
 func (s *ResourceIdentityGroupTestSuite) TestAccResourceIdentityGroup_basic() {
 	var resId, resId2 string
 	token, tokenFn := tokenize()
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// verify create w/ compartment
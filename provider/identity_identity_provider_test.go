@@ -85,7 +85,7 @@ func TestIdentityIdentityProviderResource_basic(t *testing.T) {
 	}
 	metadata := string(metadataContents)
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
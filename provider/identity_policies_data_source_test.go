@@ -44,7 +44,7 @@ func (s *DatasourceIdentityPolicyTestSuite) SetupTest() {
 }
 
 func (s *DatasourceIdentityPolicyTestSuite) TestAccDatasourceIdentityPolicies_basic() {
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			{
@@ -15,7 +15,7 @@ func TestIdentityRegionResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_identity_regions.test_regions"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -35,7 +35,7 @@ func (s *DatasourceIdentitySwiftPasswordsTestSuite) SetupTest() {
 }
 
 func (s *DatasourceIdentitySwiftPasswordsTestSuite) TestAccDatasourceIdentitySwiftPasswords_basic() {
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			{
@@ -15,7 +15,7 @@ func TestIdentityTenancyResource_basic(t *testing.T) {
 
 	singularDatasourceName := "data.oci_identity_tenancy.test_tenancy"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
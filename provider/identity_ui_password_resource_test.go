@@ -32,7 +32,7 @@ func (s *ResourceIdentityUIPasswordTestSuite) SetupTest() {
 }
 
 func (s *ResourceIdentityUIPasswordTestSuite) TestAccIdentityUIPassword_basic() {
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// verify create
@@ -32,7 +32,7 @@ func TestIdentityUiPasswordResource_basic(t *testing.T) {
 
 	resourceName := "oci_identity_ui_password.test_ui_password"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -43,7 +43,7 @@ func (s *ResourceIdentityUserGroupMembershipTestSuite) SetupTest() {
 func (s *ResourceIdentityUserGroupMembershipTestSuite) TestAccResourceUserGroupMemberships_basic() {
 	var resId, resId2 string
 
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 s.Providers,
 		Steps: []resource.TestStep{
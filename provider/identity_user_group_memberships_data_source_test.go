@@ -41,7 +41,7 @@ func (s *DatasourceIdentityUserGroupMembershipsTestSuite) SetupTest() {
 }
 
 func (s *DatasourceIdentityUserGroupMembershipsTestSuite) TestAccIdentityUserGroupMemberships_basic() {
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 s.Providers,
 		Steps: []resource.TestStep{
@@ -32,7 +32,7 @@ func (s *ResourceIdentityUserTestSuite) SetupTest() {
 func (s *ResourceIdentityUserTestSuite) TestAccResourceIdentityUser_basic() {
 	var resId, resId2 string
 	token, tokenFn := tokenize()
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// verify create w/ compartment
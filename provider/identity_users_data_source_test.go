@@ -33,7 +33,7 @@ func (s *DatasourceIdentityUsersTestSuite) SetupTest() {
 }
 
 func (s *DatasourceIdentityUsersTestSuite) TestAccDatasourceIdentityUsers_basic() {
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 s.Providers,
 		Steps: []resource.TestStep{
@@ -32,7 +32,7 @@ func TestLoadBalancerBackendHealthResource_basic(t *testing.T) {
 
 	singularDatasourceName := "data.oci_load_balancer_backend_health.test_backend_health"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -69,7 +69,7 @@ func (s *ResourceLoadBalancerBackendTestSuite) SetupTest() {
 
 func (s *ResourceLoadBalancerBackendTestSuite) TestAccResourceLoadBalancerBackend_basic() {
 	var res, res2 string
-	resource.UnitTest(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// test create minimal
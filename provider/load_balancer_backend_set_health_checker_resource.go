@@ -0,0 +1,346 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_load_balancer "github.com/oracle/oci-go-sdk/loadbalancer"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+)
+
+// BackendSetHealthCheckerResource manages the health checker of an existing backend set on its own, so that changes to
+// the health check don't rewrite the backends, policy and SSL configuration of the backend set.
+func BackendSetHealthCheckerResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: ImportBackendSetHealthChecker,
+		},
+		Timeouts: crud.DefaultTimeout,
+		Create:   createBackendSetHealthChecker,
+		Read:     readBackendSetHealthChecker,
+		Update:   updateBackendSetHealthChecker,
+		Delete:   deleteBackendSetHealthChecker,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"backendset_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"load_balancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Optional
+			"interval_ms": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30000,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"response_body_regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"retries": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3,
+			},
+			"return_code": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"timeout_in_millis": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3000,
+			},
+			"url_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed
+			// internal for work request access
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createBackendSetHealthChecker(d *schema.ResourceData, m interface{}) error {
+	sync := &BackendSetHealthCheckerResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).loadBalancerClient
+
+	return crud.CreateResource(d, sync)
+}
+
+func readBackendSetHealthChecker(d *schema.ResourceData, m interface{}) error {
+	sync := &BackendSetHealthCheckerResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).loadBalancerClient
+
+	return crud.ReadResource(sync)
+}
+
+func updateBackendSetHealthChecker(d *schema.ResourceData, m interface{}) error {
+	sync := &BackendSetHealthCheckerResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).loadBalancerClient
+
+	return crud.UpdateResource(d, sync)
+}
+
+func deleteBackendSetHealthChecker(d *schema.ResourceData, m interface{}) error {
+	sync := &BackendSetHealthCheckerResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).loadBalancerClient
+	sync.DisableNotFoundRetries = true
+
+	return crud.DeleteResource(d, sync)
+}
+
+// ImportBackendSetHealthChecker accepts IDs in the form 'loadBalancers/{loadBalancerId}/backendSets/{backendSetName}/healthChecker'.
+func ImportBackendSetHealthChecker(d *schema.ResourceData, value interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 5 || parts[0] != "loadBalancers" || parts[2] != "backendSets" || parts[4] != "healthChecker" {
+		return nil, fmt.Errorf("illegal import ID '%s', expected 'loadBalancers/{loadBalancerId}/backendSets/{backendSetName}/healthChecker'", d.Id())
+	}
+
+	d.Set("load_balancer_id", parts[1])
+	d.Set("backendset_name", parts[3])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type BackendSetHealthCheckerResourceCrud struct {
+	crud.BaseCrud
+	Client                 *oci_load_balancer.LoadBalancerClient
+	Res                    *oci_load_balancer.HealthChecker
+	DisableNotFoundRetries bool
+	WorkRequest            *oci_load_balancer.WorkRequest
+}
+
+// The health checker belongs to the backend set, which may be modified concurrently by the oci_load_balancer_backend
+// and oci_load_balancer_backend_set resources. Use the same per-backend set mutex.
+func (s *BackendSetHealthCheckerResourceCrud) GetMutex() *sync.Mutex {
+	return lbBackendSetMutexes.GetOrCreateBackendSetMutex(s.D.Get("load_balancer_id").(string), s.D.Get("backendset_name").(string))
+}
+
+func (s *BackendSetHealthCheckerResourceCrud) ID() string {
+	return fmt.Sprintf("loadBalancers/%s/backendSets/%s/healthChecker", s.D.Get("load_balancer_id").(string), s.D.Get("backendset_name").(string))
+}
+
+// Create updates the health checker that the backend set was created with, the service has no separate create operation.
+// The computed fields that aren't configured keep their current values, rather than being reset by the update.
+func (s *BackendSetHealthCheckerResourceCrud) Create() error {
+	if err := s.Get(); err != nil {
+		return err
+	}
+
+	return s.updateHealthChecker()
+}
+
+func (s *BackendSetHealthCheckerResourceCrud) Get() error {
+	request := oci_load_balancer.GetHealthCheckerRequest{}
+
+	backendSetName := s.D.Get("backendset_name").(string)
+	request.BackendSetName = &backendSetName
+
+	loadBalancerId := s.D.Get("load_balancer_id").(string)
+	request.LoadBalancerId = &loadBalancerId
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+
+	response, err := s.Client.GetHealthChecker(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.HealthChecker
+	return nil
+}
+
+func (s *BackendSetHealthCheckerResourceCrud) Update() error {
+	if err := s.updateHealthChecker(); err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+// Delete only removes the resource from the state. A backend set always has a health checker, so it is left as it was
+// last configured.
+func (s *BackendSetHealthCheckerResourceCrud) Delete() error {
+	log.Printf("[DEBUG] The health checker of backend set '%s' cannot be deleted, it is only removed from the state", s.D.Get("backendset_name").(string))
+	return nil
+}
+
+func (s *BackendSetHealthCheckerResourceCrud) SetData() {
+	if s.Res == nil {
+		return
+	}
+
+	if s.Res.IntervalInMillis != nil {
+		s.D.Set("interval_ms", *s.Res.IntervalInMillis)
+	}
+
+	if s.Res.Port != nil {
+		s.D.Set("port", *s.Res.Port)
+	}
+
+	if s.Res.Protocol != nil {
+		s.D.Set("protocol", *s.Res.Protocol)
+	}
+
+	if s.Res.ResponseBodyRegex != nil {
+		s.D.Set("response_body_regex", *s.Res.ResponseBodyRegex)
+	}
+
+	if s.Res.Retries != nil {
+		s.D.Set("retries", *s.Res.Retries)
+	}
+
+	if s.Res.ReturnCode != nil {
+		s.D.Set("return_code", *s.Res.ReturnCode)
+	}
+
+	if s.Res.TimeoutInMillis != nil {
+		s.D.Set("timeout_in_millis", *s.Res.TimeoutInMillis)
+	}
+
+	if s.Res.UrlPath != nil {
+		s.D.Set("url_path", *s.Res.UrlPath)
+	}
+}
+
+func (s *BackendSetHealthCheckerResourceCrud) updateHealthChecker() error {
+	raw := map[string]interface{}{
+		"interval_ms":         s.D.Get("interval_ms"),
+		"port":                s.D.Get("port"),
+		"protocol":            s.D.Get("protocol"),
+		"response_body_regex": s.D.Get("response_body_regex"),
+		"retries":             s.D.Get("retries"),
+		"return_code":         s.D.Get("return_code"),
+		"timeout_in_millis":   s.D.Get("timeout_in_millis"),
+		"url_path":            s.D.Get("url_path"),
+	}
+
+	// The current health checker is only read on creation, the state holds the values of the computed fields afterwards
+	if s.Res != nil {
+		if _, ok := s.D.GetOkExists("response_body_regex"); !ok && s.Res.ResponseBodyRegex != nil {
+			raw["response_body_regex"] = *s.Res.ResponseBodyRegex
+		}
+		if _, ok := s.D.GetOkExists("return_code"); !ok && s.Res.ReturnCode != nil {
+			raw["return_code"] = *s.Res.ReturnCode
+		}
+		if _, ok := s.D.GetOkExists("url_path"); !ok && s.Res.UrlPath != nil {
+			raw["url_path"] = *s.Res.UrlPath
+		}
+	}
+
+	details := mapToUpdateHealthCheckerDetails(raw)
+
+	workRequest, err := updateLoadBalancerHealthChecker(s.Client, s.D, s.D.Get("load_balancer_id").(string), s.D.Get("backendset_name").(string), details, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	s.WorkRequest = workRequest
+	return err
+}
+
+// updateLoadBalancerHealthChecker updates only the health checker of a backend set, leaving its backends and other
+// configuration untouched, and waits for the work request to complete.
+func updateLoadBalancerHealthChecker(client *oci_load_balancer.LoadBalancerClient, d *schema.ResourceData, loadBalancerId string, backendSetName string, details oci_load_balancer.UpdateHealthCheckerDetails, retryPolicy *oci_common.RetryPolicy) (*oci_load_balancer.WorkRequest, error) {
+	request := oci_load_balancer.UpdateHealthCheckerRequest{}
+	request.LoadBalancerId = &loadBalancerId
+	request.BackendSetName = &backendSetName
+	request.UpdateHealthCheckerDetails = details
+	request.RequestMetadata.RetryPolicy = retryPolicy
+
+	response, err := client.UpdateHealthChecker(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
+	getWorkRequestRequest.WorkRequestId = response.OpcWorkRequestId
+	getWorkRequestRequest.RequestMetadata.RetryPolicy = retryPolicy
+	workRequestResponse, err := client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	workRequest := &workRequestResponse.WorkRequest
+	return workRequest, crud.LoadBalancerWaitForWorkRequest(client, d, workRequest, retryPolicy)
+}
+
+// mapToUpdateHealthCheckerDetails converts the attributes of a health checker to the details of an update, in which
+// every field but the URL path is mandatory.
+func mapToUpdateHealthCheckerDetails(raw map[string]interface{}) oci_load_balancer.UpdateHealthCheckerDetails {
+	result := oci_load_balancer.UpdateHealthCheckerDetails{}
+
+	if intervalMs, ok := raw["interval_ms"]; ok {
+		tmp := intervalMs.(int)
+		result.IntervalInMillis = &tmp
+	}
+
+	if port, ok := raw["port"]; ok {
+		tmp := port.(int)
+		result.Port = &tmp
+	}
+
+	if protocol, ok := raw["protocol"]; ok {
+		tmp := protocol.(string)
+		result.Protocol = &tmp
+	}
+
+	if responseBodyRegex, ok := raw["response_body_regex"]; ok {
+		tmp := responseBodyRegex.(string)
+		result.ResponseBodyRegex = &tmp
+	}
+
+	if retries, ok := raw["retries"]; ok {
+		tmp := retries.(int)
+		result.Retries = &tmp
+	}
+
+	if returnCode, ok := raw["return_code"]; ok {
+		tmp := returnCode.(int)
+		result.ReturnCode = &tmp
+	}
+
+	if timeoutInMillis, ok := raw["timeout_in_millis"]; ok {
+		tmp := timeoutInMillis.(int)
+		result.TimeoutInMillis = &tmp
+	}
+
+	if urlPath, ok := raw["url_path"]; ok && urlPath != "" {
+		tmp := urlPath.(string)
+		result.UrlPath = &tmp
+	}
+
+	return result
+}
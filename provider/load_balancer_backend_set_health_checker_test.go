@@ -0,0 +1,265 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_load_balancer "github.com/oracle/oci-go-sdk/loadbalancer"
+)
+
+const (
+	BackendSetHealthCheckerRequiredOnlyResource = BackendSetHealthCheckerResourceDependencies + `
+resource "oci_load_balancer_backendset_health_checker" "test_backend_set_health_checker" {
+	#Required
+	backendset_name = "${oci_load_balancer_backend_set.test_backend_set.name}"
+	load_balancer_id = "${oci_load_balancer_backend_set.test_backend_set.load_balancer_id}"
+	protocol = "HTTP"
+}
+`
+
+	BackendSetHealthCheckerResourceConfig = BackendSetHealthCheckerResourceDependencies + `
+resource "oci_load_balancer_backendset_health_checker" "test_backend_set_health_checker" {
+	#Required
+	backendset_name = "${oci_load_balancer_backend_set.test_backend_set.name}"
+	load_balancer_id = "${oci_load_balancer_backend_set.test_backend_set.load_balancer_id}"
+	protocol = "HTTP"
+
+	#Optional
+	interval_ms = "${var.backend_set_health_checker_interval_ms}"
+	retries = "${var.backend_set_health_checker_retries}"
+	return_code = "${var.backend_set_health_checker_return_code}"
+	url_path = "${var.backend_set_health_checker_url_path}"
+}
+`
+	BackendSetHealthCheckerPropertyVariables = `
+variable "backend_set_health_checker_interval_ms" { default = "1000" }
+variable "backend_set_health_checker_retries" { default = 10 }
+variable "backend_set_health_checker_return_code" { default = 200 }
+variable "backend_set_health_checker_url_path" { default = "/healthcheck" }
+
+`
+	BackendSetHealthCheckerUpdatedPropertyVariables = `
+variable "backend_set_health_checker_interval_ms" { default = "2000" }
+variable "backend_set_health_checker_retries" { default = 5 }
+variable "backend_set_health_checker_return_code" { default = 204 }
+variable "backend_set_health_checker_url_path" { default = "/healthcheck2" }
+
+`
+	BackendSetHealthCheckerResourceDependencies = `
+resource "oci_load_balancer_backend_set" "test_backend_set" {
+	health_checker {
+		protocol = "HTTP"
+		url_path = "/"
+	}
+	load_balancer_id = "${oci_load_balancer_load_balancer.test_load_balancer.id}"
+	name = "backendSet1"
+	policy = "LEAST_CONNECTIONS"
+
+	# The health checker is managed by oci_load_balancer_backendset_health_checker
+	lifecycle {
+		ignore_changes = ["health_checker"]
+	}
+}
+` + LoadBalancerPropertyVariables + LoadBalancerRequiredOnlyResource
+)
+
+func TestLoadBalancerBackendSetHealthCheckerResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_load_balancer_backendset_health_checker.test_backend_set_health_checker"
+	backendSetName := "oci_load_balancer_backend_set.test_backend_set"
+
+	var resId, resId2 string
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create keeps the fields that aren't configured, and the backend set ignores the health checker
+			{
+				Config: config + compartmentIdVariableStr + BackendSetHealthCheckerRequiredOnlyResource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "protocol", "HTTP"),
+					resource.TestCheckResourceAttr(resourceName, "return_code", "200"),
+					resource.TestCheckResourceAttr(resourceName, "url_path", "/"),
+					resource.TestCheckResourceAttr(backendSetName, "health_checker.0.url_path", "/"),
+				),
+			},
+
+			// delete before next create
+			{
+				Config: config + compartmentIdVariableStr + BackendSetHealthCheckerResourceDependencies,
+			},
+			// verify create
+			{
+				Config: config + BackendSetHealthCheckerPropertyVariables + compartmentIdVariableStr + BackendSetHealthCheckerResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backendset_name", "backendSet1"),
+					resource.TestCheckResourceAttrSet(resourceName, "load_balancer_id"),
+					resource.TestCheckResourceAttr(resourceName, "interval_ms", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "HTTP"),
+					resource.TestCheckResourceAttr(resourceName, "retries", "10"),
+					resource.TestCheckResourceAttr(resourceName, "return_code", "200"),
+					resource.TestCheckResourceAttr(resourceName, "url_path", "/healthcheck"),
+
+					func(s *terraform.State) (err error) {
+						resId, err = fromInstanceState(s, resourceName, "id")
+						return err
+					},
+				),
+			},
+
+			// verify updates to updatable parameters leave the backend set in place
+			{
+				Config: config + BackendSetHealthCheckerUpdatedPropertyVariables + compartmentIdVariableStr + BackendSetHealthCheckerResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "interval_ms", "2000"),
+					resource.TestCheckResourceAttr(resourceName, "retries", "5"),
+					resource.TestCheckResourceAttr(resourceName, "return_code", "204"),
+					resource.TestCheckResourceAttr(resourceName, "url_path", "/healthcheck2"),
+					resource.TestCheckResourceAttr(backendSetName, "policy", "LEAST_CONNECTIONS"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+
+			// verify resource import
+			{
+				Config:            config,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func TestUnitImportBackendSetHealthChecker(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, BackendSetHealthCheckerResource().Schema, map[string]interface{}{})
+
+	d.SetId("loadBalancers/ocid1.loadbalancer.oc1..lb/backendSets/backendSet1/healthChecker")
+	if _, err := ImportBackendSetHealthChecker(d, nil); err != nil {
+		t.Fatalf("Unexpected error importing a valid ID: %v", err)
+	}
+	if d.Get("load_balancer_id").(string) != "ocid1.loadbalancer.oc1..lb" {
+		t.Errorf("Unexpected load_balancer_id '%s'", d.Get("load_balancer_id").(string))
+	}
+	if d.Get("backendset_name").(string) != "backendSet1" {
+		t.Errorf("Unexpected backendset_name '%s'", d.Get("backendset_name").(string))
+	}
+
+	for _, id := range []string{"backendSet1", "loadBalancers/lb/backendSets/backendSet1", "loadBalancers/lb/listeners/backendSet1/healthChecker"} {
+		d.SetId(id)
+		if _, err := ImportBackendSetHealthChecker(d, nil); err == nil {
+			t.Errorf("Expected an error importing '%s'", id)
+		}
+	}
+}
+
+func TestUnitMapToUpdateHealthCheckerDetails(t *testing.T) {
+	details := mapToUpdateHealthCheckerDetails(map[string]interface{}{
+		"interval_ms":         30000,
+		"port":                0,
+		"protocol":            "TCP",
+		"response_body_regex": "",
+		"retries":             3,
+		"return_code":         0,
+		"timeout_in_millis":   3000,
+		"url_path":            "",
+	})
+
+	// Every field but the URL path is mandatory in an update
+	if details.Port == nil || *details.Port != 0 {
+		t.Errorf("Expected the port to be sent, got %v", details.Port)
+	}
+	if details.ResponseBodyRegex == nil || *details.ResponseBodyRegex != "" {
+		t.Errorf("Expected the response body regex to be sent, got %v", details.ResponseBodyRegex)
+	}
+	if details.ReturnCode == nil || *details.ReturnCode != 0 {
+		t.Errorf("Expected the return code to be sent, got %v", details.ReturnCode)
+	}
+	if details.Protocol == nil || *details.Protocol != "TCP" {
+		t.Errorf("Expected protocol 'TCP', got %v", details.Protocol)
+	}
+	if details.UrlPath != nil {
+		t.Errorf("Expected no URL path, got '%s'", *details.UrlPath)
+	}
+}
+
+func TestUnitBackendSetHealthCheckerCreateKeepsUnconfiguredFields(t *testing.T) {
+	var updates []oci_load_balancer.UpdateHealthCheckerDetails
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "PUT":
+			var details oci_load_balancer.UpdateHealthCheckerDetails
+			json.NewDecoder(r.Body).Decode(&details)
+			updates = append(updates, details)
+			w.Header().Set("opc-work-request-id", "ocid1.loadbalancerworkrequest.oc1..test")
+		case "GET":
+			if r.URL.Path == "/loadBalancerWorkRequests/ocid1.loadbalancerworkrequest.oc1..test" {
+				fmt.Fprint(w, `{"id": "ocid1.loadbalancerworkrequest.oc1..test", "lifecycleState": "SUCCEEDED"}`)
+				return
+			}
+			fmt.Fprint(w, `{"protocol": "HTTP", "intervalInMillis": 30000, "port": 0, "retries": 3, "timeoutInMillis": 3000,
+				"returnCode": 204, "responseBodyRegex": "^ok$", "urlPath": "/health"}`)
+		}
+	}))
+	defer server.Close()
+
+	client := oci_load_balancer.LoadBalancerClient{BaseClient: oci_common.DefaultBaseClientWithSigner(testRequestSigner{})}
+	client.Host = server.URL
+	client.UserAgent = "test"
+
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"backendset_name":  "backendSet1",
+		"load_balancer_id": "ocid1.loadbalancer.oc1..test",
+		"protocol":         "HTTP",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	healthCheckerResource := BackendSetHealthCheckerResource()
+	diff, err := healthCheckerResource.Diff(nil, terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := healthCheckerResource.Apply(nil, diff, &OracleClients{loadBalancerClient: &client}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(updates) != 1 {
+		t.Fatalf("Expected the health checker to be updated once, got %d updates", len(updates))
+	}
+	update := updates[0]
+	if update.ReturnCode == nil || *update.ReturnCode != 204 {
+		t.Errorf("Expected the current return code to be kept, got %v", update.ReturnCode)
+	}
+	if update.ResponseBodyRegex == nil || *update.ResponseBodyRegex != "^ok$" {
+		t.Errorf("Expected the current response body regex to be kept, got %v", update.ResponseBodyRegex)
+	}
+	if update.UrlPath == nil || *update.UrlPath != "/health" {
+		t.Errorf("Expected the current URL path to be kept, got %v", update.UrlPath)
+	}
+}
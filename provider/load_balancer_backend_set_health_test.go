@@ -31,7 +31,7 @@ func TestLoadBalancerBackendSetHealthResource_basic(t *testing.T) {
 
 	singularDatasourceName := "data.oci_load_balancer_backend_set_health.test_backend_set_health"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -395,6 +395,20 @@ func (s *BackendSetResourceCrud) Get() error {
 }
 
 func (s *BackendSetResourceCrud) Update() error {
+	// When only the health checker changed, update it alone rather than rewriting the backends and SSL configuration
+	if !s.D.HasChange("policy") && !s.D.HasChange("session_persistence_configuration") && !s.D.HasChange("ssl_configuration") {
+		if tmpList := s.D.Get("health_checker").([]interface{}); len(tmpList) > 0 {
+			details := mapToUpdateHealthCheckerDetails(tmpList[0].(map[string]interface{}))
+			workRequest, err := updateLoadBalancerHealthChecker(s.Client, s.D, s.D.Get("load_balancer_id").(string), s.D.Get("name").(string), details, getRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+			if err != nil {
+				return err
+			}
+			s.WorkRequest = workRequest
+
+			return s.Get()
+		}
+	}
+
 	request := oci_load_balancer.UpdateBackendSetRequest{}
 
 	/*  // @CODEGEN Having 2 ways to specify backends (this and backend resource) is bad because they will override each other. Reverting to old logic.
@@ -68,7 +68,7 @@ func (s *ResourceLoadBalancerBackendSetTestSuite) SetupTest() {
 // https://docs.us-phoenix-1.oraclecloud.com/api/#/en/loadbalancer/20170115/requests/HealthCheckerDetails
 func (s *ResourceLoadBalancerBackendSetTestSuite) TestAccResourceLoadBalancerBackendSet_basic() {
 	var res, res2 string
-	resource.UnitTest(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// test create
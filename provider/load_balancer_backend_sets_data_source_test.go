@@ -78,7 +78,7 @@ func TestAccDatasourceLoadBalancerBackendsets_basic(t *testing.T) {
 
 	resourceName := "data.oci_load_balancer_backendsets.t"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 providers,
 		Steps: []resource.TestStep{
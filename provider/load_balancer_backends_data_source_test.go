@@ -68,7 +68,7 @@ func TestAccDatasourceLoadBalancerBackends_basic(t *testing.T) {
 
 	resourceName := "data.oci_load_balancer_backends.t"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 providers,
 		Steps: []resource.TestStep{
@@ -53,7 +53,7 @@ func (s *ResourceLoadBalancerCertificateTestSuite) SetupTest() {
 }
 
 func (s *ResourceLoadBalancerCertificateTestSuite) TestAccResourceLoadBalancerCertificate_basic() {
-	resource.UnitTest(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// test create
@@ -53,7 +53,7 @@ func TestLoadBalancerCertificateResource_basic(t *testing.T) {
 	resourceName := "oci_load_balancer_certificate.test_certificate"
 	datasourceName := "data.oci_load_balancer_certificates.test_certificates"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -56,7 +56,7 @@ func TestAccDatasourceLoadBalancerCertificates_basic(t *testing.T) {
 
 	resourceName := "data.oci_load_balancer_certificates.t"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 providers,
 		Steps: []resource.TestStep{
@@ -77,7 +77,7 @@ func (s *ResourceLoadBalancerListenerTestSuite) SetupTest() {
 
 func (s *ResourceLoadBalancerListenerTestSuite) TestAccResourceLoadBalancerListener_basic() {
 	var resId, resId2 string
-	resource.UnitTest(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// test create
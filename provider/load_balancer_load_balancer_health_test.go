@@ -30,7 +30,7 @@ func TestLoadBalancerLoadBalancerHealthResource_basic(t *testing.T) {
 
 	singularDatasourceName := "data.oci_load_balancer_health.test_load_balancer_health"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -62,3 +62,66 @@ data "oci_load_balancer_health" "test_load_balancer_health" {
 		},
 	})
 }
+
+func TestLoadBalancerLoadBalancerHealthsDataSource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	datasourceName := "data.oci_load_balancer_load_balancer_healths.test_load_balancer_healths"
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify datasource
+			{
+				Config: config + `
+data "oci_load_balancer_load_balancer_healths" "test_load_balancer_healths" {
+	depends_on = ["oci_load_balancer_backend.test_backend"]
+
+	#Required
+	compartment_id = "${var.compartment_id}"
+
+	#Optional
+	fail_on_critical = true
+
+	filter {
+		name = "load_balancer_id"
+		values = ["${oci_load_balancer_load_balancer.test_load_balancer.id}"]
+	}
+}
+                ` + compartmentIdVariableStr + LoadBalancerHealthResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttr(datasourceName, "load_balancer_healths.#", "1"),
+					resource.TestCheckResourceAttrSet(datasourceName, "load_balancer_healths.0.load_balancer_id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "load_balancer_healths.0.status"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestUnitCriticalLoadBalancerIds(t *testing.T) {
+	loadBalancerHealths := []map[string]interface{}{
+		{"load_balancer_id": "lb1", "status": "OK"},
+		{"load_balancer_id": "lb2", "status": "CRITICAL"},
+		{"load_balancer_id": "lb3", "status": "WARNING"},
+		{"load_balancer_id": "lb4", "status": "CRITICAL"},
+		{"load_balancer_id": "lb5", "status": "UNKNOWN"},
+	}
+
+	criticalIds := criticalLoadBalancerIds(loadBalancerHealths)
+	if len(criticalIds) != 2 || criticalIds[0] != "lb2" || criticalIds[1] != "lb4" {
+		t.Errorf("Expected [lb2 lb4], got %v", criticalIds)
+	}
+
+	if criticalIds := criticalLoadBalancerIds(loadBalancerHealths[:1]); len(criticalIds) != 0 {
+		t.Errorf("Expected no critical load balancers, got %v", criticalIds)
+	}
+}
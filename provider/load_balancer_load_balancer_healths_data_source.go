@@ -0,0 +1,157 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_load_balancer "github.com/oracle/oci-go-sdk/loadbalancer"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+)
+
+func LoadBalancerHealthsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readLoadBalancerHealths,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// Fails the read, and so the plan, when any of the filtered load balancers is CRITICAL
+			"fail_on_critical": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"load_balancer_healths": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"load_balancer_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readLoadBalancerHealths(d *schema.ResourceData, m interface{}) error {
+	sync := &LoadBalancerHealthsDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).loadBalancerClient
+
+	return crud.ReadResource(sync)
+}
+
+type LoadBalancerHealthsDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_load_balancer.LoadBalancerClient
+	Res    *oci_load_balancer.ListLoadBalancerHealthsResponse
+}
+
+func (s *LoadBalancerHealthsDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *LoadBalancerHealthsDataSourceCrud) Get() error {
+	request := oci_load_balancer.ListLoadBalancerHealthsRequest{}
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "load_balancer")
+
+	response, err := s.Client.ListLoadBalancerHealths(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	request.Page = s.Res.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListLoadBalancerHealths(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	// The check is made here rather than in SetData, so that it fails the read
+	if s.D.Get("fail_on_critical").(bool) {
+		if criticalIds := criticalLoadBalancerIds(s.loadBalancerHealths()); len(criticalIds) > 0 {
+			return fmt.Errorf("%d load balancer(s) in compartment '%s' are in the CRITICAL health state: %s", len(criticalIds), *request.CompartmentId, strings.Join(criticalIds, ", "))
+		}
+	}
+
+	return nil
+}
+
+func (s *LoadBalancerHealthsDataSourceCrud) SetData() {
+	if s.Res == nil {
+		return
+	}
+
+	s.D.SetId(crud.GenerateDataSourceID())
+
+	if err := s.D.Set("load_balancer_healths", s.loadBalancerHealths()); err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// loadBalancerHealths returns the health summaries that match the filters of the data source.
+func (s *LoadBalancerHealthsDataSourceCrud) loadBalancerHealths() []map[string]interface{} {
+	resources := []map[string]interface{}{}
+
+	for _, r := range s.Res.Items {
+		loadBalancerHealth := map[string]interface{}{}
+
+		if r.LoadBalancerId != nil {
+			loadBalancerHealth["load_balancer_id"] = *r.LoadBalancerId
+		}
+
+		loadBalancerHealth["status"] = string(r.Status)
+
+		resources = append(resources, loadBalancerHealth)
+	}
+
+	if f, fOk := s.D.GetOkExists("filter"); fOk {
+		resources = ApplyFilters(f.(*schema.Set), resources, LoadBalancerHealthsDataSource().Schema["load_balancer_healths"].Elem.(*schema.Resource).Schema)
+	}
+
+	return resources
+}
+
+func criticalLoadBalancerIds(loadBalancerHealths []map[string]interface{}) []string {
+	result := []string{}
+	for _, loadBalancerHealth := range loadBalancerHealths {
+		if loadBalancerHealth["status"] == string(oci_load_balancer.LoadBalancerHealthSummaryStatusCritical) {
+			result = append(result, fmt.Sprintf("%v", loadBalancerHealth["load_balancer_id"]))
+		}
+	}
+	return result
+}
@@ -29,7 +29,7 @@ func TestLoadBalancerLoadBalancerPolicyResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_load_balancer_policies.test_load_balancer_policies"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -29,7 +29,7 @@ func TestLoadBalancerLoadBalancerProtocolResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_load_balancer_protocols.test_load_balancer_protocols"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -29,7 +29,7 @@ func TestLoadBalancerLoadBalancerShapeResource_basic(t *testing.T) {
 
 	datasourceName := "data.oci_load_balancer_shapes.test_load_balancer_shapes"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -21,7 +21,7 @@ func TestAccDatasourceLoadBalancerShapes_basic(t *testing.T) {
 
 	resourceName := "data.oci_load_balancer_shapes.t"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 providers,
 		Steps: []resource.TestStep{
@@ -49,7 +49,7 @@ func TestAccDatasourceLoadBalancerLB_basic(t *testing.T) {
 	}`
 	resourceName := "data.oci_load_balancers.t"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 providers,
 		Steps: []resource.TestStep{
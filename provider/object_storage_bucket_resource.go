@@ -5,6 +5,7 @@ package provider
 import (
 	"context"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -193,6 +194,14 @@ func (s *BucketResourceCrud) Create() error {
 func (s *BucketResourceCrud) Get() error {
 	request := oci_object_storage.GetBucketRequest{}
 
+	// Imported buckets only have their ID, which is {namespace}/{name}
+	if _, ok := s.D.GetOkExists("name"); !ok {
+		if parts := strings.Split(s.D.Id(), "/"); len(parts) == 2 {
+			s.D.Set("namespace", parts[0])
+			s.D.Set("name", parts[1])
+		}
+	}
+
 	if name, ok := s.D.GetOkExists("name"); ok {
 		tmp := name.(string)
 		request.BucketName = &tmp
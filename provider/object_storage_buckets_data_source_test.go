@@ -41,7 +41,7 @@ func (s *DatasourceObjectstorageBucketSummaryTestSuite) SetupTest() {
 
 func (s *DatasourceObjectstorageBucketSummaryTestSuite) TestAccDatasourceObjectstorageBucketSummaries_basic() {
 	compartmentID := getCompartmentIDForLegacyTests()
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		PreventPostDestroyRefresh: true,
 		Providers:                 s.Providers,
 		Steps: []resource.TestStep{
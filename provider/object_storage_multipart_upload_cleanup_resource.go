@@ -0,0 +1,249 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+)
+
+const (
+	MultipartUploadCleanupIdPrefix = "tfobm-multipart-upload-cleanup-"
+)
+
+// MultipartUploadCleanupResource aborts the multipart uploads of a bucket that were started more than 'max_age_in_hours'
+// ago. There is nothing to create in the service, the resource only keeps track of the bucket to clean up. When stale
+// uploads show up again, the resource is removed from the state on refresh so that the next apply aborts them.
+func MultipartUploadCleanupResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: crud.DefaultTimeout,
+		Create:   createMultipartUploadCleanup,
+		Read:     readMultipartUploadCleanup,
+		Update:   updateMultipartUploadCleanup,
+		Delete:   deleteMultipartUploadCleanup,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"max_age_in_hours": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 24*365),
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed
+			"aborted_uploads": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"upload_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createMultipartUploadCleanup(d *schema.ResourceData, m interface{}) error {
+	sync := &MultipartUploadCleanupResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+
+	return crud.CreateResource(d, sync)
+}
+
+func readMultipartUploadCleanup(d *schema.ResourceData, m interface{}) error {
+	sync := &MultipartUploadCleanupResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+
+	return crud.ReadResource(sync)
+}
+
+func updateMultipartUploadCleanup(d *schema.ResourceData, m interface{}) error {
+	sync := &MultipartUploadCleanupResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+
+	return crud.UpdateResource(d, sync)
+}
+
+func deleteMultipartUploadCleanup(d *schema.ResourceData, m interface{}) error {
+	sync := &MultipartUploadCleanupResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+
+	return crud.DeleteResource(d, sync)
+}
+
+type MultipartUploadCleanup struct {
+	NamespaceName string
+	BucketName    string
+	// Uploads that are older than 'max_age_in_hours' and still in progress
+	StaleUploads []oci_object_storage.MultipartUpload
+	// Uploads aborted by the last Create or Update, nil if neither ran
+	AbortedUploads []oci_object_storage.MultipartUpload
+}
+
+type MultipartUploadCleanupResourceCrud struct {
+	crud.BaseCrud
+	Client *oci_object_storage.ObjectStorageClient
+	Res    *MultipartUploadCleanup
+}
+
+func getMultipartUploadCleanupId(namespaceName string, bucketName string) string {
+	return MultipartUploadCleanupIdPrefix + namespaceName + ObjIdDelim + bucketName
+}
+
+func parseMultipartUploadCleanupId(id string) (namespaceName string, bucketName string) {
+	parts := strings.Split(strings.TrimPrefix(id, MultipartUploadCleanupIdPrefix), ObjIdDelim)
+	if len(parts) != 2 {
+		panic(fmt.Sprintf("Illegal id %s encountered", id))
+	}
+	return parts[0], parts[1]
+}
+
+func (s *MultipartUploadCleanupResourceCrud) ID() string {
+	return getMultipartUploadCleanupId(s.Res.NamespaceName, s.Res.BucketName)
+}
+
+func (s *MultipartUploadCleanupResourceCrud) Create() error {
+	return s.abortStaleUploads(s.D.Get("namespace").(string), s.D.Get("bucket").(string))
+}
+
+func (s *MultipartUploadCleanupResourceCrud) Get() error {
+	namespaceName, bucketName := parseMultipartUploadCleanupId(s.D.Id())
+
+	staleUploads, err := s.listStaleUploads(namespaceName, bucketName)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &MultipartUploadCleanup{
+		NamespaceName: namespaceName,
+		BucketName:    bucketName,
+		StaleUploads:  staleUploads,
+	}
+
+	return nil
+}
+
+func (s *MultipartUploadCleanupResourceCrud) Update() error {
+	namespaceName, bucketName := parseMultipartUploadCleanupId(s.D.Id())
+	return s.abortStaleUploads(namespaceName, bucketName)
+}
+
+// Delete only removes the resource from the state, uploads that are still in progress are left as they are.
+func (s *MultipartUploadCleanupResourceCrud) Delete() error {
+	return nil
+}
+
+func (s *MultipartUploadCleanupResourceCrud) SetData() {
+	s.D.Set("namespace", s.Res.NamespaceName)
+	s.D.Set("bucket", s.Res.BucketName)
+
+	if s.Res.AbortedUploads != nil {
+		abortedUploads := []map[string]interface{}{}
+		for _, upload := range s.Res.AbortedUploads {
+			abortedUpload := MultipartUploadToMap(upload)
+			delete(abortedUpload, "bucket")
+			delete(abortedUpload, "namespace")
+			abortedUploads = append(abortedUploads, abortedUpload)
+		}
+		s.D.Set("aborted_uploads", abortedUploads)
+	}
+
+	if len(s.Res.StaleUploads) > 0 {
+		log.Printf("[DEBUG] Found %d stale multipart uploads in bucket '%s', marking them for cleanup", len(s.Res.StaleUploads), s.Res.BucketName)
+		s.VoidState()
+	}
+}
+
+func (s *MultipartUploadCleanupResourceCrud) abortStaleUploads(namespaceName string, bucketName string) error {
+	staleUploads, err := s.listStaleUploads(namespaceName, bucketName)
+	if err != nil {
+		return err
+	}
+
+	abortedUploads := []oci_object_storage.MultipartUpload{}
+	for _, upload := range staleUploads {
+		err := abortMultipartUpload(s.Client, namespaceName, bucketName, *upload.Object, *upload.UploadId)
+		if serviceError, ok := oci_common.IsServiceError(err); ok && serviceError.GetHTTPStatusCode() == 404 {
+			// The upload has been committed or aborted since it was listed
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to abort multipart upload %s of object '%s': %v", *upload.UploadId, *upload.Object, err)
+		}
+		abortedUploads = append(abortedUploads, upload)
+	}
+
+	s.D.SetId(getMultipartUploadCleanupId(namespaceName, bucketName))
+	s.Res = &MultipartUploadCleanup{
+		NamespaceName:  namespaceName,
+		BucketName:     bucketName,
+		AbortedUploads: abortedUploads,
+	}
+
+	return nil
+}
+
+func (s *MultipartUploadCleanupResourceCrud) listStaleUploads(namespaceName string, bucketName string) ([]oci_object_storage.MultipartUpload, error) {
+	uploads, err := listMultipartUploads(s.Client, namespaceName, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	maxAge := time.Duration(s.D.Get("max_age_in_hours").(int)) * time.Hour
+	prefix := s.D.Get("prefix").(string)
+
+	staleUploads := []oci_object_storage.MultipartUpload{}
+	for _, upload := range uploads {
+		if upload.Object == nil || upload.UploadId == nil || upload.TimeCreated == nil {
+			continue
+		}
+		if !strings.HasPrefix(*upload.Object, prefix) {
+			continue
+		}
+		if time.Since(upload.TimeCreated.Time) > maxAge {
+			staleUploads = append(staleUploads, upload)
+		}
+	}
+
+	return staleUploads, nil
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+)
+
+func MultipartUploadPartsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readMultipartUploadParts,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"object": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"upload_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// Computed
+			"multipart_upload_parts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"md5": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"part_number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readMultipartUploadParts(d *schema.ResourceData, m interface{}) error {
+	sync := &MultipartUploadPartsDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+
+	return crud.ReadResource(sync)
+}
+
+type MultipartUploadPartsDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_object_storage.ObjectStorageClient
+	Res    *oci_object_storage.ListMultipartUploadPartsResponse
+}
+
+func (s *MultipartUploadPartsDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *MultipartUploadPartsDataSourceCrud) Get() error {
+	request := oci_object_storage.ListMultipartUploadPartsRequest{}
+
+	if bucket, ok := s.D.GetOkExists("bucket"); ok {
+		tmp := bucket.(string)
+		request.BucketName = &tmp
+	}
+
+	if namespace, ok := s.D.GetOkExists("namespace"); ok {
+		tmp := namespace.(string)
+		request.NamespaceName = &tmp
+	}
+
+	if object, ok := s.D.GetOkExists("object"); ok {
+		tmp := object.(string)
+		request.ObjectName = &tmp
+	}
+
+	if uploadId, ok := s.D.GetOkExists("upload_id"); ok {
+		tmp := uploadId.(string)
+		request.UploadId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "object_storage")
+
+	response, err := s.Client.ListMultipartUploadParts(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	request.Page = s.Res.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListMultipartUploadParts(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return nil
+}
+
+func (s *MultipartUploadPartsDataSourceCrud) SetData() {
+	if s.Res == nil {
+		return
+	}
+
+	s.D.SetId(crud.GenerateDataSourceID())
+	resources := []map[string]interface{}{}
+
+	for _, r := range s.Res.Items {
+		multipartUploadPart := map[string]interface{}{}
+
+		if r.Etag != nil {
+			multipartUploadPart["etag"] = *r.Etag
+		}
+
+		if r.Md5 != nil {
+			multipartUploadPart["md5"] = *r.Md5
+		}
+
+		if r.PartNumber != nil {
+			multipartUploadPart["part_number"] = *r.PartNumber
+		}
+
+		if r.Size != nil {
+			multipartUploadPart["size"] = *r.Size
+		}
+
+		resources = append(resources, multipartUploadPart)
+	}
+
+	if f, fOk := s.D.GetOkExists("filter"); fOk {
+		resources = ApplyFilters(f.(*schema.Set), resources, MultipartUploadPartsDataSource().Schema["multipart_upload_parts"].Elem.(*schema.Resource).Schema)
+	}
+
+	if err := s.D.Set("multipart_upload_parts", resources); err != nil {
+		panic(err)
+	}
+
+	return
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+)
+
+const (
+	MultipartUploadDataSourceConfig = `
+data "oci_objectstorage_multipart_uploads" "test_multipart_uploads" {
+	#Required
+	bucket = "${oci_objectstorage_bucket.test_bucket.name}"
+	namespace = "${oci_objectstorage_bucket.test_bucket.namespace}"
+
+	filter {
+		name = "object"
+		values = ["my-test-multipart-upload"]
+	}
+}
+`
+
+	MultipartUploadPartsDataSourceConfig = `
+data "oci_objectstorage_multipart_upload_parts" "test_multipart_upload_parts" {
+	#Required
+	bucket = "${oci_objectstorage_bucket.test_bucket.name}"
+	namespace = "${oci_objectstorage_bucket.test_bucket.namespace}"
+	object = "${lookup(data.oci_objectstorage_multipart_uploads.test_multipart_uploads.multipart_uploads[0], "object")}"
+	upload_id = "${lookup(data.oci_objectstorage_multipart_uploads.test_multipart_uploads.multipart_uploads[0], "upload_id")}"
+}
+`
+
+	MultipartUploadCleanupResourceConfig = `
+resource "oci_objectstorage_multipart_upload_cleanup" "test_multipart_upload_cleanup" {
+	#Required
+	bucket = "${oci_objectstorage_bucket.test_bucket.name}"
+	namespace = "${oci_objectstorage_bucket.test_bucket.namespace}"
+	max_age_in_hours = 24
+
+	#Optional
+	prefix = "my-test-"
+}
+`
+)
+
+// startTestMultipartUpload starts a multipart upload with a single part, and leaves it in progress.
+func startTestMultipartUpload(t *testing.T, bucketName string, objectName string) (namespaceName string, uploadId string) {
+	client := GetTestProvider().objectStorageClient
+
+	namespaceResponse, err := client.GetNamespace(context.Background(), oci_object_storage.GetNamespaceRequest{})
+	if err != nil {
+		t.Fatalf("Unable to get the namespace. Error: %q", err)
+	}
+
+	createRequest := oci_object_storage.CreateMultipartUploadRequest{}
+	createRequest.NamespaceName = namespaceResponse.Value
+	createRequest.BucketName = &bucketName
+	createRequest.Object = &objectName
+	createResponse, err := client.CreateMultipartUpload(context.Background(), createRequest)
+	if err != nil {
+		t.Fatalf("Unable to start the multipart upload. Error: %q", err)
+	}
+
+	content := []byte("content")
+	contentLength := len(content)
+	partNum := 1
+	uploadPartRequest := oci_object_storage.UploadPartRequest{}
+	uploadPartRequest.NamespaceName = namespaceResponse.Value
+	uploadPartRequest.BucketName = &bucketName
+	uploadPartRequest.ObjectName = &objectName
+	uploadPartRequest.UploadId = createResponse.UploadId
+	uploadPartRequest.UploadPartNum = &partNum
+	uploadPartRequest.ContentLength = &contentLength
+	uploadPartRequest.UploadPartBody = ioutil.NopCloser(bytes.NewReader(content))
+	if _, err := client.UploadPart(context.Background(), uploadPartRequest); err != nil {
+		t.Fatalf("Unable to upload part of the multipart upload. Error: %q", err)
+	}
+
+	return *namespaceResponse.Value, *createResponse.UploadId
+}
+
+func TestObjectStorageMultipartUploadResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	uploadsDatasourceName := "data.oci_objectstorage_multipart_uploads.test_multipart_uploads"
+	partsDatasourceName := "data.oci_objectstorage_multipart_upload_parts.test_multipart_upload_parts"
+	cleanupResourceName := "oci_objectstorage_multipart_upload_cleanup.test_multipart_upload_cleanup"
+
+	var namespaceName, uploadId string
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// create the bucket that the upload is started in
+			{
+				Config: config + BucketPropertyVariables + compartmentIdVariableStr + BucketRequiredOnlyResource,
+			},
+			// verify datasources
+			{
+				PreConfig: func() {
+					namespaceName, uploadId = startTestMultipartUpload(t, "my-test-1", "my-test-multipart-upload")
+				},
+				Config: config + BucketPropertyVariables + compartmentIdVariableStr + BucketRequiredOnlyResource + MultipartUploadDataSourceConfig + MultipartUploadPartsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(uploadsDatasourceName, "bucket", "my-test-1"),
+					resource.TestCheckResourceAttrSet(uploadsDatasourceName, "namespace"),
+					resource.TestCheckResourceAttr(uploadsDatasourceName, "multipart_uploads.#", "1"),
+					resource.TestCheckResourceAttr(uploadsDatasourceName, "multipart_uploads.0.bucket", "my-test-1"),
+					resource.TestCheckResourceAttrSet(uploadsDatasourceName, "multipart_uploads.0.namespace"),
+					resource.TestCheckResourceAttr(uploadsDatasourceName, "multipart_uploads.0.object", "my-test-multipart-upload"),
+					resource.TestCheckResourceAttrSet(uploadsDatasourceName, "multipart_uploads.0.time_created"),
+					resource.TestCheckResourceAttrSet(uploadsDatasourceName, "multipart_uploads.0.upload_id"),
+
+					resource.TestCheckResourceAttr(partsDatasourceName, "multipart_upload_parts.#", "1"),
+					resource.TestCheckResourceAttrSet(partsDatasourceName, "multipart_upload_parts.0.etag"),
+					resource.TestCheckResourceAttrSet(partsDatasourceName, "multipart_upload_parts.0.md5"),
+					resource.TestCheckResourceAttr(partsDatasourceName, "multipart_upload_parts.0.part_number", "1"),
+					resource.TestCheckResourceAttr(partsDatasourceName, "multipart_upload_parts.0.size", "7"),
+				),
+			},
+			// verify uploads that are more recent than max_age_in_hours are not aborted
+			{
+				Config: config + BucketPropertyVariables + compartmentIdVariableStr + BucketRequiredOnlyResource + MultipartUploadDataSourceConfig + MultipartUploadCleanupResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cleanupResourceName, "bucket", "my-test-1"),
+					resource.TestCheckResourceAttrSet(cleanupResourceName, "namespace"),
+					resource.TestCheckResourceAttr(cleanupResourceName, "max_age_in_hours", "24"),
+					resource.TestCheckResourceAttr(cleanupResourceName, "prefix", "my-test-"),
+					resource.TestCheckResourceAttr(cleanupResourceName, "aborted_uploads.#", "0"),
+					resource.TestCheckResourceAttr(uploadsDatasourceName, "multipart_uploads.#", "1"),
+				),
+			},
+			// abort the upload so that the bucket can be deleted
+			{
+				PreConfig: func() {
+					err := abortMultipartUpload(GetTestProvider().objectStorageClient, namespaceName, "my-test-1", "my-test-multipart-upload", uploadId)
+					if err != nil {
+						t.Fatalf("Unable to abort the multipart upload. Error: %q", err)
+					}
+				},
+				Config: config + BucketPropertyVariables + compartmentIdVariableStr + BucketRequiredOnlyResource + MultipartUploadDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(uploadsDatasourceName, "multipart_uploads.#", "0"),
+				),
+			},
+		},
+	})
+}
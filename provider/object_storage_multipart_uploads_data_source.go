@@ -0,0 +1,161 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+)
+
+func MultipartUploadsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readMultipartUploads,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// Computed
+			"multipart_uploads": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"upload_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readMultipartUploads(d *schema.ResourceData, m interface{}) error {
+	sync := &MultipartUploadsDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+
+	return crud.ReadResource(sync)
+}
+
+type MultipartUploadsDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_object_storage.ObjectStorageClient
+	Res    *oci_object_storage.ListMultipartUploadsResponse
+}
+
+func (s *MultipartUploadsDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *MultipartUploadsDataSourceCrud) Get() error {
+	request := oci_object_storage.ListMultipartUploadsRequest{}
+
+	if bucket, ok := s.D.GetOkExists("bucket"); ok {
+		tmp := bucket.(string)
+		request.BucketName = &tmp
+	}
+
+	if namespace, ok := s.D.GetOkExists("namespace"); ok {
+		tmp := namespace.(string)
+		request.NamespaceName = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "object_storage")
+
+	response, err := s.Client.ListMultipartUploads(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	request.Page = s.Res.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListMultipartUploads(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return nil
+}
+
+func (s *MultipartUploadsDataSourceCrud) SetData() {
+	if s.Res == nil {
+		return
+	}
+
+	s.D.SetId(crud.GenerateDataSourceID())
+	resources := []map[string]interface{}{}
+
+	for _, r := range s.Res.Items {
+		resources = append(resources, MultipartUploadToMap(r))
+	}
+
+	if f, fOk := s.D.GetOkExists("filter"); fOk {
+		resources = ApplyFilters(f.(*schema.Set), resources, MultipartUploadsDataSource().Schema["multipart_uploads"].Elem.(*schema.Resource).Schema)
+	}
+
+	if err := s.D.Set("multipart_uploads", resources); err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+func MultipartUploadToMap(obj oci_object_storage.MultipartUpload) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if obj.Bucket != nil {
+		result["bucket"] = string(*obj.Bucket)
+	}
+
+	if obj.Namespace != nil {
+		result["namespace"] = string(*obj.Namespace)
+	}
+
+	if obj.Object != nil {
+		result["object"] = string(*obj.Object)
+	}
+
+	if obj.TimeCreated != nil {
+		result["time_created"] = obj.TimeCreated.String()
+	}
+
+	if obj.UploadId != nil {
+		result["upload_id"] = string(*obj.UploadId)
+	}
+
+	return result
+}
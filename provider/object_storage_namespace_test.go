@@ -15,7 +15,7 @@ func TestObjectStorageNamespaceResource_basic(t *testing.T) {
 
 	singularDatasourceName := "data.oci_objectstorage_namespace.test_namespace"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -0,0 +1,242 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+)
+
+const (
+	defaultObjectContentLengthLimit = 1024 * 1024
+)
+
+func ObjectDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readSingularObject,
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"object": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"base64_encode_content": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"content_length_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultObjectContentLengthLimit,
+				ValidateFunc: validation.IntBetween(1, math.MaxInt32),
+			},
+			"output_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_base64": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_length": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"content_md5": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func readSingularObject(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+
+	return crud.ReadResource(sync)
+}
+
+type ObjectDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_object_storage.ObjectStorageClient
+	Res    *oci_object_storage.GetObjectResponse
+	// The body of the object, nil when it was written to 'output_path' instead
+	Content []byte
+}
+
+func (s *ObjectDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *ObjectDataSourceCrud) Get() error {
+	request := oci_object_storage.GetObjectRequest{}
+
+	if namespace, ok := s.D.GetOkExists("namespace"); ok {
+		tmp := namespace.(string)
+		request.NamespaceName = &tmp
+	}
+
+	if bucket, ok := s.D.GetOkExists("bucket"); ok {
+		tmp := bucket.(string)
+		request.BucketName = &tmp
+	}
+
+	if object, ok := s.D.GetOkExists("object"); ok {
+		tmp := object.(string)
+		request.ObjectName = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "object_storage")
+
+	response, err := s.Client.GetObject(context.Background(), request)
+	if err != nil {
+		return err
+	}
+	defer response.Content.Close()
+
+	s.Res = &response
+
+	// The body is read here rather than in SetData, so that failures are reported
+	if outputPath, ok := s.D.GetOkExists("output_path"); ok && outputPath.(string) != "" {
+		return writeObjectContentToFile(response.Content, outputPath.(string))
+	}
+
+	s.Content, err = readObjectContent(response.Content, response.ContentLength, s.D.Get("content_length_limit").(int))
+	if err != nil {
+		return fmt.Errorf("unable to read object '%s': %v", *request.ObjectName, err)
+	}
+
+	if !s.D.Get("base64_encode_content").(bool) && !utf8.Valid(s.Content) {
+		return fmt.Errorf("object '%s' is not valid UTF-8 text, set 'base64_encode_content' to read it as 'content_base64'", *request.ObjectName)
+	}
+
+	return nil
+}
+
+func (s *ObjectDataSourceCrud) SetData() {
+	if s.Res == nil {
+		return
+	}
+
+	s.D.SetId(crud.GenerateDataSourceID())
+
+	if s.Content != nil {
+		if s.D.Get("base64_encode_content").(bool) {
+			s.D.Set("content_base64", base64.StdEncoding.EncodeToString(s.Content))
+		} else {
+			s.D.Set("content", string(s.Content))
+		}
+	}
+
+	if s.Res.ContentLength != nil {
+		s.D.Set("content_length", *s.Res.ContentLength)
+	}
+
+	if s.Res.ContentMd5 != nil {
+		s.D.Set("content_md5", *s.Res.ContentMd5)
+	} else if s.Res.OpcMultipartMd5 != nil {
+		s.D.Set("content_md5", *s.Res.OpcMultipartMd5)
+	}
+
+	if s.Res.ContentType != nil {
+		s.D.Set("content_type", *s.Res.ContentType)
+	}
+
+	if s.Res.OpcMeta != nil {
+		if err := s.D.Set("metadata", s.Res.OpcMeta); err != nil {
+			log.Printf("Unable to set `metadata`. Error %q", err)
+		}
+	}
+
+	return
+}
+
+// readObjectContent reads the body of an object into memory, failing if it is larger than 'limit' bytes. The length
+// reported by the service is checked first, and the read itself is bounded in case the length was not reported.
+func readObjectContent(body io.Reader, contentLength *int, limit int) ([]byte, error) {
+	if contentLength != nil && *contentLength > limit {
+		return nil, fmt.Errorf("the object is %d bytes, which is larger than 'content_length_limit' of %d bytes", *contentLength, limit)
+	}
+
+	content, err := ioutil.ReadAll(io.LimitReader(body, int64(limit)+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) > limit {
+		return nil, fmt.Errorf("the object is larger than 'content_length_limit' of %d bytes", limit)
+	}
+
+	return content, nil
+}
+
+// writeObjectContentToFile writes the content to a temporary file next to outputPath, and renames it once it is
+// complete, so that a failed download never leaves a truncated file at outputPath.
+func writeObjectContentToFile(body io.Reader, outputPath string) (e error) {
+	file, err := ioutil.TempFile(filepath.Dir(outputPath), "."+filepath.Base(outputPath)+".")
+	if err != nil {
+		return fmt.Errorf("unable to create 'output_path' %s: %v", outputPath, err)
+	}
+	defer func() {
+		if e != nil {
+			file.Close()
+			os.Remove(file.Name())
+		}
+	}()
+
+	if _, err := io.Copy(file, body); err != nil {
+		return fmt.Errorf("unable to write 'output_path' %s: %v", outputPath, err)
+	}
+
+	// Temporary files are only readable by their owner
+	if err := file.Chmod(0644); err != nil {
+		return fmt.Errorf("unable to write 'output_path' %s: %v", outputPath, err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("unable to write 'output_path' %s: %v", outputPath, err)
+	}
+
+	if err := os.Rename(file.Name(), outputPath); err != nil {
+		return fmt.Errorf("unable to write 'output_path' %s: %v", outputPath, err)
+	}
+
+	return nil
+}
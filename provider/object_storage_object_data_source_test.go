@@ -0,0 +1,174 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestObjectStorageObjectDataSource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	outputDir, err := ioutil.TempDir("", "tf-test-object-data-source")
+	if err != nil {
+		t.Fatalf("Unable to create a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(outputDir)
+	outputPath := filepath.Join(outputDir, "content.txt")
+
+	singularDatasourceName := "data.oci_objectstorage_object.test_object"
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify text content
+			{
+				Config: config + `
+data "oci_objectstorage_object" "test_object" {
+	#Required
+	bucket = "${oci_objectstorage_object.test_object.bucket}"
+	namespace = "${oci_objectstorage_object.test_object.namespace}"
+	object = "${oci_objectstorage_object.test_object.object}"
+}
+                ` + ObjectPropertyVariables + compartmentIdVariableStr + ObjectResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(singularDatasourceName, "content", "content"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "content_base64", ""),
+					resource.TestCheckResourceAttr(singularDatasourceName, "content_length", "7"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "content_md5"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "metadata.%", "1"),
+				),
+			},
+			// verify base64 content
+			{
+				Config: config + `
+data "oci_objectstorage_object" "test_object" {
+	#Required
+	bucket = "${oci_objectstorage_object.test_object.bucket}"
+	namespace = "${oci_objectstorage_object.test_object.namespace}"
+	object = "${oci_objectstorage_object.test_object.object}"
+
+	#Optional
+	base64_encode_content = true
+}
+                ` + ObjectPropertyVariables + compartmentIdVariableStr + ObjectResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(singularDatasourceName, "content", ""),
+					resource.TestCheckResourceAttr(singularDatasourceName, "content_base64", "Y29udGVudA=="),
+				),
+			},
+			// verify content written to a file
+			{
+				Config: config + fmt.Sprintf(`
+data "oci_objectstorage_object" "test_object" {
+	#Required
+	bucket = "${oci_objectstorage_object.test_object.bucket}"
+	namespace = "${oci_objectstorage_object.test_object.namespace}"
+	object = "${oci_objectstorage_object.test_object.object}"
+
+	#Optional
+	output_path = "%s"
+}
+                `, outputPath) + ObjectPropertyVariables + compartmentIdVariableStr + ObjectResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(singularDatasourceName, "content", ""),
+					resource.TestCheckResourceAttr(singularDatasourceName, "content_length", "7"),
+					func(s *terraform.State) error {
+						content, err := ioutil.ReadFile(outputPath)
+						if err != nil {
+							return err
+						}
+						if string(content) != "content" {
+							return fmt.Errorf("expected 'content' in %s, got '%s'", outputPath, content)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitReadObjectContent(t *testing.T) {
+	length := 5
+	content, err := readObjectContent(bytes.NewBufferString("hello"), &length, 5)
+	if err != nil {
+		t.Fatalf("Unexpected error reading content within the limit: %v", err)
+	}
+	if string(content) != "hello" {
+		t.Errorf("Expected 'hello', got '%s'", content)
+	}
+
+	// The reported length is checked before the body is read
+	if _, err := readObjectContent(bytes.NewBufferString("hello"), &length, 4); err == nil {
+		t.Errorf("Expected an error reading content larger than the limit")
+	}
+
+	// Without a reported length the read is bounded
+	if _, err := readObjectContent(bytes.NewBufferString("hello"), nil, 4); err == nil {
+		t.Errorf("Expected an error reading unbounded content larger than the limit")
+	}
+
+	content, err = readObjectContent(bytes.NewBufferString(""), nil, 4)
+	if err != nil {
+		t.Fatalf("Unexpected error reading empty content: %v", err)
+	}
+	if content == nil || len(content) != 0 {
+		t.Errorf("Expected empty content, got %v", content)
+	}
+}
+
+// failingReader returns some content, then an error, like a download that is interrupted.
+type failingReader struct {
+	read bool
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.read {
+		return 0, fmt.Errorf("connection reset")
+	}
+	r.read = true
+	return copy(p, "partial"), nil
+}
+
+func TestUnitWriteObjectContentToFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "object_content")
+	if err != nil {
+		t.Fatalf("Unable to create a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	outputPath := filepath.Join(dir, "object.txt")
+
+	if err := writeObjectContentToFile(bytes.NewBufferString("hello"), outputPath); err != nil {
+		t.Fatalf("Unexpected error writing the content: %v", err)
+	}
+	if content, err := ioutil.ReadFile(outputPath); err != nil || string(content) != "hello" {
+		t.Errorf("Expected the file to contain 'hello', got '%s', %v", content, err)
+	}
+
+	// A failed download leaves the previous file in place, and no temporary file
+	if err := writeObjectContentToFile(&failingReader{}, outputPath); err == nil {
+		t.Errorf("Expected an error writing the content of a failed download")
+	}
+	if content, err := ioutil.ReadFile(outputPath); err != nil || string(content) != "hello" {
+		t.Errorf("Expected the file to still contain 'hello', got '%s', %v", content, err)
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Errorf("Expected only the output file to be left, got %d files", len(files))
+	}
+}
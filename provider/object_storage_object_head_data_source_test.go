@@ -47,7 +47,7 @@ func (s *DatasourceObjectstorageObjectHeadTestSuite) SetupTest() {
 }
 
 func (s *DatasourceObjectstorageObjectHeadTestSuite) TestObjectstorageObjectHead_basic() {
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			{
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	"github.com/oracle/terraform-provider-oci/crud"
 
@@ -16,6 +17,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"os"
 	"strconv"
 
 	"strings"
@@ -69,8 +71,9 @@ func ObjectResource() *schema.Resource {
 				Type: schema.TypeString,
 				// @CODEGEN 2/2018: content is optional and stored as checksum to avoid bloating the state file
 				// Generator was setting it as required.
-				Optional: true,
-				ForceNew: true,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source"},
 				StateFunc: func(body interface{}) string {
 					v := body.(string)
 					if v == "" {
@@ -115,12 +118,50 @@ func ObjectResource() *schema.Resource {
 				ValidateFunc: validateLowerCaseKeysInMetadata,
 				ForceNew:     true,
 			},
+			// The multipart arguments only affect how 'source' is uploaded. They have no default in the schema, so
+			// that objects created before they existed don't diff, and changing them doesn't upload the object again.
+			"multipart_parallel_uploads": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, maxMultipartParallelUploads),
+			},
+			"multipart_part_size_in_mbs": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(minMultipartPartSizeInMBs, maxMultipartPartSizeInMBs),
+			},
+			"source": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"content"},
+			},
+			// Set by a refresh when the 'source' file no longer matches the object, so that the plan uploads it again.
+			// It is not in the configuration, so a true value in the state is a diff that forces a new resource.
+			"source_changed": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSourceChangedNotSet,
+			},
 
 			// Computed
+			// The part size that 'source' was last uploaded with, which its multipart MD5 depends on
+			"source_part_size_in_mbs": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
 
+func validateSourceChangedNotSet(v interface{}, k string) (ws []string, errors []error) {
+	if v.(bool) {
+		errors = append(errors, fmt.Errorf("'%s' is set by the provider on refresh, and can't be set", k))
+	}
+	return
+}
+
 func createObject(d *schema.ResourceData, m interface{}) error {
 	sync := &ObjectResourceCrud{}
 	sync.D = d
@@ -249,6 +290,10 @@ func (s *ObjectResourceCrud) Create() error {
 		request.ObjectName = &tmp
 	}
 
+	if source, ok := s.D.GetOkExists("source"); ok && source.(string) != "" {
+		return s.createFromSource(request, source.(string))
+	}
+
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	_, err := s.Client.PutObject(context.Background(), request)
@@ -276,6 +321,12 @@ func (s *ObjectResourceCrud) Get() error {
 		return fmt.Errorf("'namespace', 'bucket', or 'object' identifiers are missing")
 	}
 
+	// Objects uploaded from a 'source' file can be many GBs in size and their content is never stored in the state,
+	// so only retrieve their headers.
+	if source, ok := s.D.GetOkExists("source"); ok && source.(string) != "" {
+		return s.getHead(namespaceName, bucketName, objectName)
+	}
+
 	// TODO: May be better to use HeadObject() to retrieve status of the object. For large content, doesn't make sense
 	// to call Get() all the time
 	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
@@ -297,11 +348,127 @@ func (s *ObjectResourceCrud) Get() error {
 	return nil
 }
 
+// createFromSource streams the 'source' file into the object. Files larger than 'multipart_part_size_in_mbs' are
+// uploaded in parts, 'multipart_parallel_uploads' at a time.
+func (s *ObjectResourceCrud) createFromSource(request oci_object_storage.PutObjectRequest, source string) error {
+	file, err := os.Open(source)
+	if err != nil {
+		return fmt.Errorf("unable to open 'source' %s: %v", source, err)
+	}
+	defer file.Close()
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("unable to read 'source' %s: %v", source, err)
+	}
+
+	partSize := s.multipartPartSize()
+	if err := putObjectFromFile(s.Client, request, file, fileInfo.Size(), partSize, s.multipartParallelUploads()); err != nil {
+		return err
+	}
+	s.D.Set("source_part_size_in_mbs", int(partSize/bytesInMB))
+
+	s.D.SetId(getId(*request.NamespaceName, *request.BucketName, *request.ObjectName))
+
+	return s.Get()
+}
+
+func (s *ObjectResourceCrud) getHead(namespaceName string, bucketName string, objectName string) error {
+	request := oci_object_storage.HeadObjectRequest{}
+	request.NamespaceName = &namespaceName
+	request.BucketName = &bucketName
+	request.ObjectName = &objectName
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+	response, err := s.Client.HeadObject(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &ObjectStorageObject{
+		GetObjectResponse: oci_object_storage.GetObjectResponse{
+			RawResponse:     response.RawResponse,
+			ETag:            response.ETag,
+			OpcMeta:         response.OpcMeta,
+			ContentLength:   response.ContentLength,
+			ContentMd5:      response.ContentMd5,
+			OpcMultipartMd5: response.OpcMultipartMd5,
+			ContentType:     response.ContentType,
+			ContentLanguage: response.ContentLanguage,
+			ContentEncoding: response.ContentEncoding,
+			LastModified:    response.LastModified,
+		},
+		NamespaceName: namespaceName,
+		BucketName:    bucketName,
+		ObjectName:    objectName,
+	}
+
+	return nil
+}
+
+func (s *ObjectResourceCrud) multipartPartSize() int64 {
+	if partSize, ok := s.D.GetOk("multipart_part_size_in_mbs"); ok {
+		return int64(partSize.(int)) * bytesInMB
+	}
+	return defaultMultipartPartSizeInMBs * bytesInMB
+}
+
+func (s *ObjectResourceCrud) multipartParallelUploads() int {
+	if parallelUploads, ok := s.D.GetOk("multipart_parallel_uploads"); ok {
+		return parallelUploads.(int)
+	}
+	return defaultMultipartParallelUploads
+}
+
+// detectSourceDrift compares the MD5 of the local 'source' file with the MD5 reported by the service, and sets
+// 'source_changed' if they don't match, so that the next plan uploads the file again. The object stays in the state,
+// so that it is still deleted on destroy.
+func (s *ObjectResourceCrud) detectSourceDrift(source string) {
+	if s.Res.ContentMd5 == nil && s.Res.OpcMultipartMd5 == nil {
+		return
+	}
+
+	remoteMd5 := s.D.Get("content_md5").(string)
+	var localMd5 string
+	var err error
+	if s.Res.OpcMultipartMd5 != nil {
+		uploadedPartSize, hasUploadedPartSize := s.D.GetOk("source_part_size_in_mbs")
+		if hasUploadedPartSize {
+			localMd5, err = multipartMd5OfFile(source, int64(uploadedPartSize.(int))*bytesInMB)
+		} else {
+			// Objects uploaded before the part size was stored are compared with the configured part size
+			localMd5, err = multipartMd5OfFile(source, s.multipartPartSize())
+			if err == nil && multipartMd5PartCount(localMd5) != multipartMd5PartCount(remoteMd5) {
+				log.Printf("[DEBUG] 'source' %s was uploaded in parts of another size than 'multipart_part_size_in_mbs', skipping drift detection", source)
+				return
+			}
+		}
+	} else {
+		localMd5, err = md5OfFile(source)
+	}
+
+	if err != nil {
+		// The file may only be available on the machine that applied the configuration, don't treat it as drift
+		log.Printf("[WARN] Unable to compute the MD5 of 'source' %s, skipping drift detection. Error: %q", source, err)
+		return
+	}
+
+	if localMd5 != remoteMd5 {
+		log.Printf("[DEBUG] MD5 of 'source' %s is '%s' but the object has '%s', marking it for upload", source, localMd5, remoteMd5)
+	}
+	s.D.Set("source_changed", localMd5 != remoteMd5)
+}
+
 func (s *ObjectResourceCrud) Update() error {
 	id := s.D.Id()
 	namespaceName, bucketName, objectName := parseId(id)
 	// @CODEGEN 06/2018: Update is only supported for the change in name - all others are a forceNew
 	if !s.D.HasChange("object") {
+		// The multipart arguments only affect how the object is uploaded, there is nothing to update remotely
+		if s.D.HasChange("multipart_parallel_uploads") || s.D.HasChange("multipart_part_size_in_mbs") {
+			return s.Get()
+		}
 		return fmt.Errorf("unexpected change encountered")
 	}
 	request := oci_object_storage.RenameObjectRequest{}
@@ -343,13 +510,16 @@ func (s *ObjectResourceCrud) SetData() {
 	s.D.Set("bucket", s.Res.BucketName)
 	s.D.Set("object", s.Res.ObjectName)
 
-	contentReader := s.Res.Content
-	contentArray, err := ioutil.ReadAll(contentReader)
-	if err != nil {
-		log.Printf("Unable to read 'content' from response. Error: %q", err)
-		return
+	// Content is not retrieved for objects uploaded from a 'source' file, see Get()
+	if s.Res.Content != nil {
+		contentReader := s.Res.Content
+		contentArray, err := ioutil.ReadAll(contentReader)
+		if err != nil {
+			log.Printf("Unable to read 'content' from response. Error: %q", err)
+			return
+		}
+		s.D.Set("content", contentArray)
 	}
-	s.D.Set("content", contentArray)
 
 	if s.Res.ContentEncoding != nil {
 		s.D.Set("content_encoding", *s.Res.ContentEncoding)
@@ -365,6 +535,13 @@ func (s *ObjectResourceCrud) SetData() {
 
 	if s.Res.ContentMd5 != nil {
 		s.D.Set("content_md5", *s.Res.ContentMd5)
+	} else if s.Res.OpcMultipartMd5 != nil {
+		// Objects uploaded in multiple parts have no MD5 of the whole body, only an MD5 of the part MD5s
+		s.D.Set("content_md5", *s.Res.OpcMultipartMd5)
+	}
+
+	if source, ok := s.D.GetOkExists("source"); ok && source.(string) != "" {
+		s.detectSourceDrift(source.(string))
 	}
 
 	if s.Res.ContentType != nil {
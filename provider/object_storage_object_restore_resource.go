@@ -0,0 +1,217 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+)
+
+const objectRestorePollInterval = time.Minute
+
+// ObjectRestoreResource restores an object of an Archive tier bucket for a number of hours, and waits until it can be
+// downloaded. Once the restore expires and the object is archived again, the resource is removed from the state on
+// refresh so that the next apply restores it again.
+func ObjectRestoreResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: &crud.FourHours,
+		},
+		Create: createObjectRestore,
+		Read:   readObjectRestore,
+		Delete: deleteObjectRestore,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"object": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      24,
+				ValidateFunc: validation.IntBetween(1, 240),
+			},
+
+			// Computed
+			"archival_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_of_archival": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createObjectRestore(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectRestoreResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+
+	return crud.CreateResource(d, sync)
+}
+
+func readObjectRestore(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectRestoreResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+
+	return crud.ReadResource(sync)
+}
+
+func deleteObjectRestore(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+type ObjectRestoreResourceCrud struct {
+	crud.BaseCrud
+	Client                 *oci_object_storage.ObjectStorageClient
+	Res                    *oci_object_storage.HeadObjectResponse
+	DisableNotFoundRetries bool
+}
+
+func (s *ObjectRestoreResourceCrud) ID() string {
+	return getId(s.D.Get("namespace").(string), s.D.Get("bucket").(string), s.D.Get("object").(string))
+}
+
+func (s *ObjectRestoreResourceCrud) Create() error {
+	if err := s.Get(); err != nil {
+		return err
+	}
+
+	// A restore that is already in progress can't be requested again, just wait for it
+	if s.Res.ArchivalState != oci_object_storage.HeadObjectArchivalStateRestoring {
+		request := oci_object_storage.RestoreObjectsRequest{}
+
+		namespace := s.D.Get("namespace").(string)
+		request.NamespaceName = &namespace
+
+		bucket := s.D.Get("bucket").(string)
+		request.BucketName = &bucket
+
+		object := s.D.Get("object").(string)
+		request.ObjectName = &object
+
+		hours := s.D.Get("hours").(int)
+		request.Hours = &hours
+
+		request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+		if _, err := s.Client.RestoreObjects(context.Background(), request); err != nil {
+			return err
+		}
+	}
+
+	// Restores take hours, so the object is polled at a fixed interval rather than with a growing backoff
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			string(oci_object_storage.HeadObjectArchivalStateArchived),
+			string(oci_object_storage.HeadObjectArchivalStateRestoring),
+		},
+		Target: []string{
+			string(oci_object_storage.HeadObjectArchivalStateRestored),
+		},
+		Refresh: func() (interface{}, string, error) {
+			if err := s.Get(); err != nil {
+				return nil, "", err
+			}
+			return s.Res, string(s.Res.ArchivalState), nil
+		},
+		Timeout:      s.D.Timeout(schema.TimeoutCreate),
+		PollInterval: crud.WaitDuration(s.Client.HTTPClient, objectRestorePollInterval),
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func (s *ObjectRestoreResourceCrud) Get() error {
+	request := oci_object_storage.HeadObjectRequest{}
+
+	namespace := s.D.Get("namespace").(string)
+	request.NamespaceName = &namespace
+
+	bucket := s.D.Get("bucket").(string)
+	request.BucketName = &bucket
+
+	object := s.D.Get("object").(string)
+	request.ObjectName = &object
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+	ociResponse, err := oci_common.Retry(context.Background(), request, s.headObject, *request.RequestMetadata.RetryPolicy)
+	if err != nil {
+		return err
+	}
+
+	response := ociResponse.(oci_object_storage.HeadObjectResponse)
+	s.Res = &response
+	return nil
+}
+
+// headObject sends a HeadObject request like the client does, except for the archival-state header: the SDK panics
+// setting a header of an enum type, so it is read from the raw response instead.
+func (s *ObjectRestoreResourceCrud) headObject(ctx context.Context, request oci_common.OCIRequest) (oci_common.OCIResponse, error) {
+	httpRequest, err := request.HTTPRequest(http.MethodHead, "/n/{namespaceName}/b/{bucketName}/o/{objectName}")
+	if err != nil {
+		return nil, err
+	}
+
+	var response oci_object_storage.HeadObjectResponse
+	httpResponse, err := s.Client.Call(ctx, &httpRequest)
+	defer oci_common.CloseBodyIfValid(httpResponse)
+	response.RawResponse = httpResponse
+	if err != nil {
+		return response, err
+	}
+
+	archivalState := httpResponse.Header.Get("archival-state")
+	httpResponse.Header.Del("archival-state")
+	if err := oci_common.UnmarshalResponse(httpResponse, &response); err != nil {
+		return response, err
+	}
+
+	response.ArchivalState = oci_object_storage.HeadObjectArchivalStateEnum(archivalState)
+	return response, nil
+}
+
+func (s *ObjectRestoreResourceCrud) SetData() {
+	s.D.Set("archival_state", s.Res.ArchivalState)
+
+	if s.Res.TimeOfArchival != nil {
+		s.D.Set("time_of_archival", s.Res.TimeOfArchival.String())
+	}
+
+	if s.Res.ArchivalState == oci_object_storage.HeadObjectArchivalStateArchived {
+		log.Printf("[DEBUG] Object '%s' has been archived again, marking it for restore", s.D.Get("object").(string))
+		s.VoidState()
+	}
+}
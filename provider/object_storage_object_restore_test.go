@@ -0,0 +1,174 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+)
+
+const (
+	ObjectRestoreRequiredOnlyResource = ObjectRestoreResourceDependencies + `
+resource "oci_objectstorage_object_restore" "test_object_restore" {
+	#Required
+	bucket = "${oci_objectstorage_object.test_archived_object.bucket}"
+	namespace = "${oci_objectstorage_object.test_archived_object.namespace}"
+	object = "${oci_objectstorage_object.test_archived_object.object}"
+}
+`
+
+	ObjectRestoreResourceConfig = ObjectRestoreResourceDependencies + `
+resource "oci_objectstorage_object_restore" "test_object_restore" {
+	#Required
+	bucket = "${oci_objectstorage_object.test_archived_object.bucket}"
+	namespace = "${oci_objectstorage_object.test_archived_object.namespace}"
+	object = "${oci_objectstorage_object.test_archived_object.object}"
+
+	#Optional
+	hours = "${var.object_restore_hours}"
+}
+`
+	ObjectRestorePropertyVariables = `
+variable "object_restore_hours" { default = 1 }
+
+`
+	ObjectRestoreResourceDependencies = BucketResourceDependencies + `
+resource "oci_objectstorage_bucket" "test_archive_bucket" {
+	compartment_id = "${var.compartment_id}"
+	name = "tf-test-archive-bucket"
+	namespace = "${data.oci_objectstorage_namespace.t.namespace}"
+	storage_tier = "Archive"
+}
+
+resource "oci_objectstorage_object" "test_archived_object" {
+	bucket = "${oci_objectstorage_bucket.test_archive_bucket.name}"
+	namespace = "${oci_objectstorage_bucket.test_archive_bucket.namespace}"
+	object = "tf-test-archived-object"
+	content = "archived content"
+}
+`
+)
+
+func TestObjectStorageObjectRestoreResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_objectstorage_object_restore.test_object_restore"
+
+	var resId, resId2 string
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + compartmentIdVariableStr + ObjectRestoreRequiredOnlyResource,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "archival_state", "RESTORED"),
+					resource.TestCheckResourceAttr(resourceName, "bucket", "tf-test-archive-bucket"),
+					resource.TestCheckResourceAttr(resourceName, "hours", "24"),
+					resource.TestCheckResourceAttrSet(resourceName, "namespace"),
+					resource.TestCheckResourceAttr(resourceName, "object", "tf-test-archived-object"),
+					resource.TestCheckResourceAttrSet(resourceName, "time_of_archival"),
+
+					func(s *terraform.State) (err error) {
+						resId, err = fromInstanceState(s, resourceName, "id")
+						return err
+					},
+				),
+			},
+
+			// verify the restore is requested again when the hours change
+			{
+				Config: config + ObjectRestorePropertyVariables + compartmentIdVariableStr + ObjectRestoreResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "archival_state", "RESTORED"),
+					resource.TestCheckResourceAttr(resourceName, "hours", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "time_of_archival"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = fromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("expected the same ID for a restore of the same object, got '%s' and '%s'", resId, resId2)
+						}
+						return err
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitObjectRestoreWaitsForArchivalState(t *testing.T) {
+	for _, testCase := range []struct {
+		archivalStates   []string
+		expectedRestores int32
+		expectedError    string
+	}{
+		{archivalStates: []string{"ARCHIVED", "RESTORED"}, expectedRestores: 1},
+		{archivalStates: []string{"RESTORING", "RESTORED"}, expectedRestores: 0},
+		// Objects of Standard tier buckets can't be restored, which must fail rather than wait until the timeout
+		{archivalStates: []string{"AVAILABLE"}, expectedRestores: 1, expectedError: "unexpected state 'AVAILABLE'"},
+	} {
+		var heads, restores int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "POST" {
+				atomic.AddInt32(&restores, 1)
+				return
+			}
+			count := int(atomic.AddInt32(&heads, 1))
+			if count > len(testCase.archivalStates) {
+				count = len(testCase.archivalStates)
+			}
+			w.Header().Set("archival-state", testCase.archivalStates[count-1])
+			if testCase.archivalStates[count-1] == "RESTORED" {
+				w.Header().Set("time-of-archival", "2018-07-01T10:00:00Z")
+			}
+		}))
+
+		client := oci_object_storage.ObjectStorageClient{BaseClient: oci_common.DefaultBaseClientWithSigner(testRequestSigner{})}
+		client.Host = server.URL
+		client.UserAgent = "test"
+
+		rawConfig, err := config.NewRawConfig(map[string]interface{}{
+			"bucket":    "archive",
+			"namespace": "namespace",
+			"object":    "object",
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		restoreResource := ObjectRestoreResource()
+		diff, err := restoreResource.Diff(nil, terraform.NewResourceConfig(rawConfig))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		state, err := restoreResource.Apply(nil, diff, &OracleClients{objectStorageClient: &client})
+		server.Close()
+
+		if testCase.expectedError == "" && (err != nil || state.Attributes["archival_state"] != "RESTORED" || state.Attributes["time_of_archival"] == "") {
+			t.Errorf("Expected the object to be restored from %v, got %v, %v", testCase.archivalStates, err, state)
+		}
+		if testCase.expectedError != "" && (err == nil || !strings.Contains(err.Error(), testCase.expectedError)) {
+			t.Errorf("Expected the error '%s' from %v, got %v", testCase.expectedError, testCase.archivalStates, err)
+		}
+		if restores != testCase.expectedRestores {
+			t.Errorf("Expected %d restore requests from %v, got %d", testCase.expectedRestores, testCase.archivalStates, restores)
+		}
+	}
+}
@@ -0,0 +1,422 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+
+	"github.com/oracle/terraform-provider-oci/crud"
+)
+
+// ObjectSetResource keeps the objects under a prefix of a bucket in sync with the files of a local directory. Files
+// are compared with the objects by MD5, so only new and changed files are uploaded. When a file changes after an
+// apply, a refresh sets 'source_changed', in the same way as for an object uploaded from a 'source' file, so that the
+// next apply updates the set and uploads the file again.
+func ObjectSetResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: crud.DefaultTimeout,
+		Create:   createObjectSet,
+		Read:     readObjectSet,
+		Update:   updateObjectSet,
+		Delete:   deleteObjectSet,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Optional
+			"delete_removed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateObjectSetPattern,
+				},
+			},
+			"include": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateObjectSetPattern,
+				},
+			},
+			"multipart_part_size_in_mbs": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultMultipartPartSizeInMBs,
+				ValidateFunc: validation.IntBetween(minMultipartPartSizeInMBs, maxMultipartPartSizeInMBs),
+			},
+			"parallel_uploads": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultMultipartParallelUploads,
+				ValidateFunc: validation.IntBetween(1, maxMultipartParallelUploads),
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			// Set by a refresh when the files of 'source_dir' no longer match the objects, so that the plan updates the set.
+			// It is not in the configuration, so a true value in the state is a diff.
+			"source_changed": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ValidateFunc: validateSourceChangedNotSet,
+			},
+
+			// Computed
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     ObjectsDataSource().Schema["objects"].Elem,
+			},
+		},
+	}
+}
+
+func createObjectSet(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectSetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+
+	return crud.CreateResource(d, sync)
+}
+
+func readObjectSet(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectSetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+
+	return crud.ReadResource(sync)
+}
+
+func updateObjectSet(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectSetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+
+	return crud.UpdateResource(d, sync)
+}
+
+func deleteObjectSet(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectSetResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*OracleClients).objectStorageClient
+	sync.DisableNotFoundRetries = true
+
+	return crud.DeleteResource(d, sync)
+}
+
+type ObjectSetResourceCrud struct {
+	crud.BaseCrud
+	Client *oci_object_storage.ObjectStorageClient
+	// The objects under 'prefix'
+	Res *[]oci_object_storage.ObjectSummary
+	// The files of 'source_dir' by object name, nil if the directory can't be read
+	LocalFiles map[string]string
+	// Whether the files and objects were found to differ on refresh
+	Drifted                bool
+	DisableNotFoundRetries bool
+}
+
+func (s *ObjectSetResourceCrud) ID() string {
+	return getId(s.D.Get("namespace").(string), s.D.Get("bucket").(string), s.D.Get("prefix").(string))
+}
+
+func (s *ObjectSetResourceCrud) Create() error {
+	return s.sync()
+}
+
+func (s *ObjectSetResourceCrud) Update() error {
+	return s.sync()
+}
+
+func (s *ObjectSetResourceCrud) Get() error {
+	if err := s.listObjects(); err != nil {
+		return err
+	}
+
+	localFiles, err := s.listLocalFiles()
+	if err != nil {
+		// The directory may only be available on the machine that applied the configuration, don't treat it as drift
+		log.Printf("[WARN] Unable to read 'source_dir' %s, skipping drift detection. Error: %q", s.D.Get("source_dir").(string), err)
+		return nil
+	}
+	s.LocalFiles = localFiles
+
+	s.Drifted = s.hasDrifted()
+	return nil
+}
+
+// sync uploads the files of 'source_dir' that are missing or differ from their objects, and deletes the objects under
+// 'prefix' that have no file if 'delete_removed' is set.
+func (s *ObjectSetResourceCrud) sync() error {
+	// Without a prefix, every object of the bucket that has no file would be deleted
+	if s.D.Get("delete_removed").(bool) && s.D.Get("prefix").(string) == "" {
+		return fmt.Errorf("'prefix' must be set when 'delete_removed' is set")
+	}
+
+	sourceDir := s.D.Get("source_dir").(string)
+	localFiles, err := s.listLocalFiles()
+	if err != nil {
+		return fmt.Errorf("unable to read 'source_dir' %s: %v", sourceDir, err)
+	}
+	s.LocalFiles = localFiles
+
+	if err := s.listObjects(); err != nil {
+		return err
+	}
+
+	objectsToUpload, err := s.objectsToUpload()
+	if err != nil {
+		return err
+	}
+
+	parallelUploads := s.D.Get("parallel_uploads").(int)
+	if len(objectsToUpload) > 0 {
+		log.Printf("[DEBUG] Uploading %d files of %s", len(objectsToUpload), sourceDir)
+		if err := forEachObjectInParallel(objectsToUpload, parallelUploads, s.uploadObject); err != nil {
+			return fmt.Errorf("unable to upload the files of %s: %v", sourceDir, err)
+		}
+	}
+
+	if s.D.Get("delete_removed").(bool) {
+		if objectsToDelete := s.objectsToDelete(); len(objectsToDelete) > 0 {
+			log.Printf("[DEBUG] Deleting %d objects that are not in %s", len(objectsToDelete), sourceDir)
+			if err := forEachObjectInParallel(objectsToDelete, parallelUploads, s.deleteObject); err != nil {
+				return fmt.Errorf("unable to delete the objects removed from %s: %v", sourceDir, err)
+			}
+		}
+	}
+
+	return s.listObjects()
+}
+
+func (s *ObjectSetResourceCrud) listLocalFiles() (map[string]string, error) {
+	return listObjectSetFiles(s.D.Get("source_dir").(string), s.D.Get("prefix").(string), s.patterns("include"), s.patterns("exclude"))
+}
+
+func (s *ObjectSetResourceCrud) patterns(fieldName string) []string {
+	patterns := []string{}
+	for _, pattern := range s.D.Get(fieldName).([]interface{}) {
+		patterns = append(patterns, pattern.(string))
+	}
+	return patterns
+}
+
+func (s *ObjectSetResourceCrud) listObjects() error {
+	objects, err := listAllObjects(s.Client, s.D.Get("namespace").(string), s.D.Get("bucket").(string), s.D.Get("prefix").(string))
+	if err != nil {
+		return err
+	}
+
+	s.Res = &objects
+	return nil
+}
+
+// objectsToUpload returns the names of the objects whose file is not in the bucket or has a different MD5.
+func (s *ObjectSetResourceCrud) objectsToUpload() ([]string, error) {
+	remoteMd5s := map[string]string{}
+	for _, object := range *s.Res {
+		if object.Name != nil && object.Md5 != nil {
+			remoteMd5s[*object.Name] = *object.Md5
+		}
+	}
+
+	partSize := int64(s.D.Get("multipart_part_size_in_mbs").(int)) * bytesInMB
+	objectNames := []string{}
+	for objectName, filePath := range s.LocalFiles {
+		remoteMd5, ok := remoteMd5s[objectName]
+		if ok {
+			matches, err := fileMatchesMd5(filePath, remoteMd5, partSize)
+			if err != nil {
+				return nil, fmt.Errorf("unable to compute the MD5 of %s: %v", filePath, err)
+			}
+			if matches {
+				continue
+			}
+		}
+		objectNames = append(objectNames, objectName)
+	}
+
+	sort.Strings(objectNames)
+	return objectNames, nil
+}
+
+// objectsToDelete returns the names of the objects under 'prefix' that have no file. Objects that the include and
+// exclude patterns leave out of the set are never deleted, whether or not they have a file.
+func (s *ObjectSetResourceCrud) objectsToDelete() []string {
+	prefix := s.D.Get("prefix").(string)
+	include := s.patterns("include")
+	exclude := s.patterns("exclude")
+
+	objectNames := []string{}
+	for _, object := range *s.Res {
+		if object.Name == nil || !objectSetIncludes(strings.TrimPrefix(*object.Name, prefix), include, exclude) {
+			continue
+		}
+		if _, ok := s.LocalFiles[*object.Name]; !ok {
+			objectNames = append(objectNames, *object.Name)
+		}
+	}
+
+	return objectNames
+}
+
+func (s *ObjectSetResourceCrud) uploadObject(objectName string) error {
+	filePath := s.LocalFiles[objectName]
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	request := oci_object_storage.PutObjectRequest{}
+
+	namespace := s.D.Get("namespace").(string)
+	request.NamespaceName = &namespace
+
+	bucket := s.D.Get("bucket").(string)
+	request.BucketName = &bucket
+
+	request.ObjectName = &objectName
+
+	// Without a content type the service stores the object as application/octet-stream
+	if contentType := mime.TypeByExtension(filepath.Ext(filePath)); contentType != "" {
+		request.ContentType = &contentType
+	}
+
+	partSize := int64(s.D.Get("multipart_part_size_in_mbs").(int)) * bytesInMB
+	return putObjectFromFile(s.Client, request, file, fileInfo.Size(), partSize, s.D.Get("parallel_uploads").(int))
+}
+
+func (s *ObjectSetResourceCrud) deleteObject(objectName string) error {
+	request := oci_object_storage.DeleteObjectRequest{}
+
+	namespace := s.D.Get("namespace").(string)
+	request.NamespaceName = &namespace
+
+	bucket := s.D.Get("bucket").(string)
+	request.BucketName = &bucket
+
+	request.ObjectName = &objectName
+
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+	_, err := s.Client.DeleteObject(context.Background(), request)
+	if serviceError, ok := oci_common.IsServiceError(err); ok && serviceError.GetHTTPStatusCode() == 404 {
+		// The object has been deleted outside of Terraform
+		return nil
+	}
+	return err
+}
+
+// Delete removes the objects of the set, other objects under 'prefix' are left in the bucket.
+func (s *ObjectSetResourceCrud) Delete() error {
+	objectNames := []string{}
+	for _, object := range s.D.Get("objects").([]interface{}) {
+		objectNames = append(objectNames, object.(map[string]interface{})["name"].(string))
+	}
+
+	return forEachObjectInParallel(objectNames, s.D.Get("parallel_uploads").(int), s.deleteObject)
+}
+
+func (s *ObjectSetResourceCrud) SetData() {
+	namespaceName, bucketName, prefix := parseId(s.D.Id())
+	s.D.Set("namespace", namespaceName)
+	s.D.Set("bucket", bucketName)
+	s.D.Set("prefix", prefix)
+
+	// The set is made of the objects that have a file. It keeps the objects it had before if 'source_dir' can't be
+	// read, or until the next apply synchronizes the bucket, so that destroying it still deletes them.
+	managedObjects := map[string]bool{}
+	for objectName := range s.LocalFiles {
+		managedObjects[objectName] = true
+	}
+	if s.LocalFiles == nil || s.Drifted {
+		for _, object := range s.D.Get("objects").([]interface{}) {
+			managedObjects[object.(map[string]interface{})["name"].(string)] = true
+		}
+	}
+
+	objects := []map[string]interface{}{}
+	for _, object := range *s.Res {
+		if object.Name != nil && managedObjects[*object.Name] {
+			objects = append(objects, ObjectSummaryToMap(object))
+		}
+	}
+	s.D.Set("objects", objects)
+
+	if s.LocalFiles != nil {
+		s.D.Set("source_changed", s.Drifted)
+	}
+}
+
+// hasDrifted reports whether any file has to be uploaded, or any object deleted, in which case 'source_changed' is set
+// so that the next apply synchronizes the bucket again.
+func (s *ObjectSetResourceCrud) hasDrifted() bool {
+	sourceDir := s.D.Get("source_dir").(string)
+
+	objectsToUpload, err := s.objectsToUpload()
+	if err != nil {
+		log.Printf("[WARN] Unable to compare the files of %s with their objects, skipping drift detection. Error: %q", sourceDir, err)
+		return false
+	}
+
+	if len(objectsToUpload) > 0 {
+		log.Printf("[DEBUG] %d files of %s differ from their objects, marking the set for upload", len(objectsToUpload), sourceDir)
+		return true
+	}
+
+	if s.D.Get("delete_removed").(bool) && len(s.objectsToDelete()) > 0 {
+		log.Printf("[DEBUG] Objects under '%s' were removed from %s, marking them for deletion", s.D.Get("prefix").(string), sourceDir)
+		return true
+	}
+
+	return false
+}
+
+func validateObjectSetPattern(raw interface{}, fieldName string) ([]string, []error) {
+	pattern := raw.(string)
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, []error{fmt.Errorf("'%s' is not a valid pattern in '%s': %v", pattern, fieldName, err)}
+	}
+	return nil, nil
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+)
+
+const (
+	ObjectSetResourceConfig = ObjectSetResourceDependencies + `
+resource "oci_objectstorage_object_set" "test_object_set" {
+	#Required
+	bucket = "${oci_objectstorage_bucket.test_bucket.name}"
+	namespace = "${oci_objectstorage_bucket.test_bucket.namespace}"
+	source_dir = "${var.object_set_source_dir}"
+
+	#Optional
+	delete_removed = "${var.object_set_delete_removed}"
+	exclude = ["${var.object_set_exclude}"]
+	prefix = "${var.object_set_prefix}"
+}
+`
+	ObjectSetPropertyVariables = `
+variable "object_set_delete_removed" { default = true }
+variable "object_set_exclude" { default = "*.tmp" }
+variable "object_set_prefix" { default = "site/" }
+
+`
+	ObjectSetResourceDependencies = BucketRequiredOnlyResource + BucketPropertyVariables
+)
+
+func TestObjectStorageObjectSetResource_basic(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	sourceDir, err := ioutil.TempDir("", "tf-test-object-set")
+	if err != nil {
+		t.Fatalf("Unable to create a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(sourceDir)
+	sourceDirVariableStr := fmt.Sprintf("variable \"object_set_source_dir\" { default = \"%s\" }\n", sourceDir)
+
+	writeFile := func(relativePath string, content string) {
+		filePath := filepath.Join(sourceDir, filepath.FromSlash(relativePath))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatalf("Unable to create a directory: %v", err)
+		}
+		if err := ioutil.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatalf("Unable to write a file: %v", err)
+		}
+	}
+	writeFile("index.html", "<html></html>")
+	writeFile("css/site.css", "body {}")
+	writeFile("notes.tmp", "excluded")
+
+	resourceName := "oci_objectstorage_object_set.test_object_set"
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + ObjectSetPropertyVariables + compartmentIdVariableStr + sourceDirVariableStr + ObjectSetResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "bucket"),
+					resource.TestCheckResourceAttrSet(resourceName, "namespace"),
+					resource.TestCheckResourceAttr(resourceName, "prefix", "site/"),
+					resource.TestCheckResourceAttr(resourceName, "objects.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "objects.0.name", "site/css/site.css"),
+					resource.TestCheckResourceAttr(resourceName, "objects.0.size", "7"),
+					resource.TestCheckResourceAttr(resourceName, "objects.1.name", "site/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.1.md5"),
+				),
+			},
+
+			// verify changed, added and removed files are synchronized
+			{
+				PreConfig: func() {
+					writeFile("index.html", "<html><body></body></html>")
+					writeFile("js/site.js", "")
+					if err := os.Remove(filepath.Join(sourceDir, "css", "site.css")); err != nil {
+						t.Fatalf("Unable to remove a file: %v", err)
+					}
+				},
+				Config: config + ObjectSetPropertyVariables + compartmentIdVariableStr + sourceDirVariableStr + ObjectSetResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "objects.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "objects.0.name", "site/index.html"),
+					resource.TestCheckResourceAttr(resourceName, "objects.0.size", "26"),
+					resource.TestCheckResourceAttr(resourceName, "objects.1.name", "site/js/site.js"),
+					resource.TestCheckResourceAttr(resourceName, "objects.1.size", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source_changed", "false"),
+				),
+			},
+
+			// verify the objects can be read back through the objects datasource
+			{
+				Config: config + `
+data "oci_objectstorage_objects" "test_objects" {
+	bucket = "${oci_objectstorage_object_set.test_object_set.bucket}"
+	namespace = "${oci_objectstorage_object_set.test_object_set.namespace}"
+	prefix = "${oci_objectstorage_object_set.test_object_set.prefix}"
+}
+                ` + ObjectSetPropertyVariables + compartmentIdVariableStr + sourceDirVariableStr + ObjectSetResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.oci_objectstorage_objects.test_objects", "objects.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitObjectSetObjectsToDelete(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ObjectSetResource().Schema, map[string]interface{}{
+		"bucket":         "bucket",
+		"namespace":      "namespace",
+		"source_dir":     "site",
+		"delete_removed": true,
+		"exclude":        []interface{}{"*.tmp"},
+		"prefix":         "site/",
+	})
+	sync := &ObjectSetResourceCrud{}
+	sync.D = d
+	sync.LocalFiles = map[string]string{"site/index.html": "site/index.html"}
+	sync.Res = &[]oci_object_storage.ObjectSummary{
+		{Name: oci_common.String("site/index.html")},
+		{Name: oci_common.String("site/old.html")},
+		// Excluded objects are left alone even though they have no file
+		{Name: oci_common.String("site/cache.tmp")},
+	}
+
+	if objectNames := sync.objectsToDelete(); fmt.Sprint(objectNames) != "[site/old.html]" {
+		t.Errorf("Expected only site/old.html to be deleted, got %v", objectNames)
+	}
+}
+
+func TestUnitObjectSetDeleteRemovedRequiresPrefix(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ObjectSetResource().Schema, map[string]interface{}{
+		"bucket":         "bucket",
+		"namespace":      "namespace",
+		"source_dir":     "site",
+		"delete_removed": true,
+	})
+	sync := &ObjectSetResourceCrud{}
+	sync.D = d
+
+	if err := sync.Create(); err == nil || !strings.Contains(err.Error(), "'prefix' must be set") {
+		t.Errorf("Expected an error deleting removed objects without a prefix, got %v", err)
+	}
+}
+
+func TestUnitObjectSetSourceChanged(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ObjectSetResource().Schema, map[string]interface{}{
+		"bucket":     "bucket",
+		"namespace":  "namespace",
+		"source_dir": "site",
+		"prefix":     "site/",
+	})
+	d.SetId(getId("namespace", "bucket", "site/"))
+	d.Set("objects", []interface{}{
+		map[string]interface{}{"name": "site/index.html"},
+		map[string]interface{}{"name": "site/old.html"},
+	})
+	sync := &ObjectSetResourceCrud{}
+	sync.D = d
+	// site/old.html was removed from 'source_dir' after the last apply
+	sync.LocalFiles = map[string]string{"site/index.html": "site/index.html"}
+	sync.Res = &[]oci_object_storage.ObjectSummary{
+		{Name: oci_common.String("site/index.html")},
+		{Name: oci_common.String("site/old.html")},
+	}
+	sync.Drifted = true
+	sync.SetData()
+
+	if d.Id() == "" {
+		t.Errorf("Expected the set to stay in the state after its files changed")
+	}
+	if !d.Get("source_changed").(bool) {
+		t.Errorf("Expected 'source_changed' to be set after the files changed")
+	}
+	if objectsCount := d.Get("objects.#").(int); objectsCount != 2 {
+		t.Errorf("Expected the set to keep its 2 objects until the next apply, got %d", objectsCount)
+	}
+
+	state := &terraform.InstanceState{
+		ID: d.Id(),
+		Attributes: map[string]string{
+			"id":                         d.Id(),
+			"bucket":                     "bucket",
+			"namespace":                  "namespace",
+			"source_dir":                 "site",
+			"prefix":                     "site/",
+			"delete_removed":             "false",
+			"multipart_part_size_in_mbs": fmt.Sprint(defaultMultipartPartSizeInMBs),
+			"parallel_uploads":           fmt.Sprint(defaultMultipartParallelUploads),
+			"source_changed":             "true",
+		},
+	}
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{"bucket": "bucket", "namespace": "namespace", "source_dir": "site", "prefix": "site/"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	instanceDiff, err := ObjectSetResource().Diff(state, terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if instanceDiff == nil || instanceDiff.Empty() || instanceDiff.RequiresNew() {
+		t.Errorf("Expected changed files to update the set in place, got %v", instanceDiff)
+	}
+}
@@ -7,11 +7,19 @@ import (
 	"testing"
 
 	"crypto/md5"
+	"crypto/rand"
 	"encoding/hex"
+	"io/ioutil"
+	"os"
 	"regexp"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	oci_object_storage "github.com/oracle/oci-go-sdk/objectstorage"
+
+	"github.com/oracle/terraform-provider-oci/crud"
 )
 
 const (
@@ -67,7 +75,7 @@ func TestObjectStorageObjectResource_basic(t *testing.T) {
 	hexSum2 := md5.Sum([]byte("<a1>content</a1>"))
 	md5sum2 := hex.EncodeToString(hexSum2[:])
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -271,3 +279,222 @@ data "oci_objectstorage_objects" "test_objects" {
 		},
 	})
 }
+
+func TestObjectStorageObjectResource_source(t *testing.T) {
+	provider := testAccProvider
+	config := testProviderConfig()
+
+	compartmentId := getRequiredEnvSetting("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_objectstorage_object.test_object"
+
+	// 25 MiB is uploaded in 3 parts of at most 10 MiB each
+	sourceFile, err := ioutil.TempFile("", "tf-object-source")
+	if err != nil {
+		t.Fatalf("Unable to create source file. Error: %q", err)
+	}
+	defer os.Remove(sourceFile.Name())
+
+	writeSource := func(size int) {
+		content := make([]byte, size)
+		rand.Read(content)
+		if err := ioutil.WriteFile(sourceFile.Name(), content, 0644); err != nil {
+			t.Fatalf("Unable to write source file. Error: %q", err)
+		}
+	}
+	writeSource(25 * bytesInMB)
+
+	sourceConfig := fmt.Sprintf(`
+resource "oci_objectstorage_object" "test_object" {
+	#Required
+	bucket = "${oci_objectstorage_bucket.test_bucket.name}"
+	namespace = "${oci_objectstorage_bucket.test_bucket.namespace}"
+	object = "my-test-object-source"
+
+	#Optional
+	source = "%s"
+	multipart_part_size_in_mbs = 10
+	multipart_parallel_uploads = 2
+}
+`, sourceFile.Name())
+
+	resourceTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify multipart create
+			{
+				Config: config + compartmentIdVariableStr + ObjectResourceDependencies + sourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "object", "my-test-object-source"),
+					resource.TestCheckResourceAttr(resourceName, "source", sourceFile.Name()),
+					resource.TestCheckResourceAttr(resourceName, "content_length", fmt.Sprintf("%d", 25*bytesInMB)),
+					resource.TestMatchResourceAttr(resourceName, "content_md5", regexp.MustCompile("-3$")),
+					resource.TestCheckResourceAttr(resourceName, "source_part_size_in_mbs", "10"),
+					resource.TestCheckNoResourceAttr(resourceName, "content"),
+				),
+			},
+			// verify no diff when the source file is unchanged
+			{
+				Config:             config + compartmentIdVariableStr + ObjectResourceDependencies + sourceConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			// verify changes to the source file are detected
+			{
+				PreConfig: func() {
+					writeSource(5 * bytesInMB)
+				},
+				Config:             config + compartmentIdVariableStr + ObjectResourceDependencies + sourceConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// verify single part upload of the changed source file
+			{
+				Config: config + compartmentIdVariableStr + ObjectResourceDependencies + sourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "source", sourceFile.Name()),
+					resource.TestCheckResourceAttr(resourceName, "content_length", fmt.Sprintf("%d", 5*bytesInMB)),
+					resource.TestMatchResourceAttr(resourceName, "content_md5", regexp.MustCompile("^[^-]+$")),
+					resource.TestCheckResourceAttr(resourceName, "source_changed", "false"),
+				),
+			},
+		},
+	})
+}
+
+// Objects created before the multipart arguments existed don't have them in their state
+func TestUnitObjectResourceMultipartArgumentsDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: getId("namespace", "bucket", "object"),
+		Attributes: map[string]string{
+			"id":        getId("namespace", "bucket", "object"),
+			"namespace": "namespace",
+			"bucket":    "bucket",
+			"object":    "object",
+			"source":    "/tmp/source",
+		},
+	}
+
+	diff := func(raw map[string]interface{}) *terraform.InstanceDiff {
+		rawConfig, err := config.NewRawConfig(raw)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		instanceDiff, err := ObjectResource().Diff(state, terraform.NewResourceConfig(rawConfig))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return instanceDiff
+	}
+
+	raw := map[string]interface{}{"namespace": "namespace", "bucket": "bucket", "object": "object", "source": "/tmp/source"}
+	if instanceDiff := diff(raw); instanceDiff != nil && !instanceDiff.Empty() {
+		t.Errorf("Expected no diff without the multipart arguments, got %v", instanceDiff)
+	}
+
+	raw["multipart_part_size_in_mbs"] = 256
+	raw["multipart_parallel_uploads"] = 8
+	if instanceDiff := diff(raw); instanceDiff == nil || instanceDiff.RequiresNew() {
+		t.Errorf("Expected the multipart arguments to be updated in place, got %v", instanceDiff)
+	}
+}
+
+func TestUnitObjectResourceSourceDrift(t *testing.T) {
+	source, err := ioutil.TempFile("", "object-source")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer os.Remove(source.Name())
+	if _, err := source.Write(make([]byte, 3*bytesInMB)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	source.Close()
+
+	// The object was uploaded in 1MB parts, and the configuration has since changed the part size
+	uploadedMd5, err := multipartMd5OfFile(source.Name(), bytesInMB)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	refresh := func(uploadedPartSize int) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, ObjectResource().Schema, map[string]interface{}{
+			"namespace":                  "namespace",
+			"bucket":                     "bucket",
+			"object":                     "object",
+			"source":                     source.Name(),
+			"multipart_part_size_in_mbs": 10,
+		})
+		d.SetId(getId("namespace", "bucket", "object"))
+		if uploadedPartSize != 0 {
+			d.Set("source_part_size_in_mbs", uploadedPartSize)
+		}
+		s := &ObjectResourceCrud{
+			BaseCrud: crud.BaseCrud{D: d},
+			Res: &ObjectStorageObject{
+				NamespaceName:     "namespace",
+				BucketName:        "bucket",
+				ObjectName:        "object",
+				GetObjectResponse: oci_object_storage.GetObjectResponse{OpcMultipartMd5: &uploadedMd5},
+			},
+		}
+		s.SetData()
+		return d
+	}
+
+	if d := refresh(1); d.Get("source_changed").(bool) {
+		t.Errorf("Expected no drift when comparing with the part size of the upload")
+	}
+
+	if _, ok := refresh(0).GetOk("source_changed"); ok {
+		t.Errorf("Expected drift detection to be skipped without the part size of the upload")
+	}
+
+	if err := ioutil.WriteFile(source.Name(), make([]byte, 2*bytesInMB), 0644); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	d := refresh(1)
+	if !d.Get("source_changed").(bool) {
+		t.Errorf("Expected drift after the file changed")
+	}
+	if d.Id() == "" {
+		t.Errorf("Expected the object to stay in the state after the file changed")
+	}
+}
+
+func TestUnitObjectResourceSourceChangedDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: getId("namespace", "bucket", "object"),
+		Attributes: map[string]string{
+			"id":             getId("namespace", "bucket", "object"),
+			"namespace":      "namespace",
+			"bucket":         "bucket",
+			"object":         "object",
+			"source":         "/tmp/source",
+			"source_changed": "true",
+		},
+	}
+
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{"namespace": "namespace", "bucket": "bucket", "object": "object", "source": "/tmp/source"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	instanceDiff, err := ObjectResource().Diff(state, terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if instanceDiff == nil || !instanceDiff.RequiresNew() {
+		t.Errorf("Expected a changed source to force a new object, got %v", instanceDiff)
+	}
+
+	state.Attributes["source_changed"] = "false"
+	instanceDiff, err = ObjectResource().Diff(state, terraform.NewResourceConfig(rawConfig))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if instanceDiff != nil && !instanceDiff.Empty() {
+		t.Errorf("Expected no diff for an unchanged source, got %v", instanceDiff)
+	}
+}
@@ -143,6 +143,7 @@ func (s *ObjectsDataSourceCrud) Get() error {
 
 	// @CODEGEN 2/2018: Preserve the custom logic to extract the ObjectSummary results from ListObjects response
 	// and to handle pagination.
+	s.Res = &oci_object_storage.ListObjects{Objects: []oci_object_storage.ObjectSummary{}}
 	for {
 		request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "object_storage")
 
@@ -151,7 +152,6 @@ func (s *ObjectsDataSourceCrud) Get() error {
 			return err
 		}
 
-		s.Res = &oci_object_storage.ListObjects{Objects: []oci_object_storage.ObjectSummary{}}
 		for _, objectSummary := range response.Objects {
 			s.Res.Objects = append(s.Res.Objects, objectSummary)
 		}
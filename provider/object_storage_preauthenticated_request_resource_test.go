@@ -47,7 +47,7 @@ func (s *ResourceObjectstoragePARTestSuite) SetupTest() {
 }
 
 func (s *ResourceObjectstoragePARTestSuite) TestAccResourceObjectstoragePAR_basic() {
-	resource.Test(s.T(), resource.TestCase{
+	resourceTest(s.T(), resource.TestCase{
 		Providers: s.Providers,
 		Steps: []resource.TestStep{
 			// verify create
@@ -59,7 +59,7 @@ func TestObjectStoragePreauthenticatedRequestResource_basic(t *testing.T) {
 	datasourceName := "data.oci_objectstorage_preauthrequests.test_preauthenticated_requests"
 	singularDatasourceName := "data.oci_objectstorage_preauthrequest.test_preauthenticated_request"
 
-	resource.Test(t, resource.TestCase{
+	resourceTest(t, resource.TestCase{
 		Providers: map[string]terraform.ResourceProvider{
 			"oci": provider,
 		},
@@ -8,8 +8,10 @@ import (
 	"crypto/x509"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"runtime"
 	"strconv"
@@ -35,7 +37,6 @@ import (
 )
 
 var descriptions map[string]string
-var disableAutoRetries bool
 
 const (
 	authAPIKeySetting            = "ApiKey"
@@ -45,6 +46,8 @@ const (
 	defaultTLSHandshakeTimeout   = 5 * time.Second
 	userAgentFormatter           = "Oracle-GoSDK/%s (go/%s; %s/%s; terraform/%s) Oracle-TerraformProvider/%s"
 	r1CertLocationEnv            = "R1_CERT_LOCATION"
+	defaultConfigFilePath        = "~/.oci/config"
+	defaultConfigFileProfile     = "DEFAULT"
 )
 
 type oboTokenProviderFromEnv struct{}
@@ -59,14 +62,30 @@ func init() {
 		"tenancy_ocid": fmt.Sprintf("(Optional) The tenancy OCID for a user. The tenancy OCID can be found at the bottom of user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", authAPIKeySetting),
 		"user_ocid":    fmt.Sprintf("(Optional) The user OCID. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", authAPIKeySetting),
 		"fingerprint":  fmt.Sprintf("(Optional) The fingerprint for the user's RSA key. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", authAPIKeySetting),
-		"region":       "(Required) The region for API connections (e.g. us-ashburn-1).",
+		"region":       "(Optional) The region for API connections (e.g. us-ashburn-1). Required unless it is set in the config file profile.",
 		"private_key": "(Optional) A PEM formatted RSA private key for the user.\n" +
 			fmt.Sprintf("A private_key or a private_key_path must be provided if auth is set to '%s', ignored otherwise.", authAPIKeySetting),
 		"private_key_path": "(Optional) The path to the user's PEM formatted private key.\n" +
 			fmt.Sprintf("A private_key or a private_key_path must be provided if auth is set to '%s', ignored otherwise.", authAPIKeySetting),
 		"private_key_password": "(Optional) The password used to secure the private key.",
+		"config_file_path":     "(Optional) The path to an OCI CLI config file. Defaults to '~/.oci/config' when a config_file_profile is set.",
+		"config_file_profile": "(Optional) The profile of the OCI CLI config file to read the credentials and region from. Defaults to 'DEFAULT' when a config_file_path is set.\n" +
+			"Arguments set in the provider block take precedence over the profile.",
 		"disable_auto_retries": "(Optional) Disable Automatic retries for retriable errors.\n" +
 			"Auto retries were introduced to solve some eventual consistency problems but it also introduced performance issues on destroy operations.",
+		"retry_duration_seconds": "(Optional) The maximum duration, in seconds, for which retryable errors are retried.\n" +
+			fmt.Sprintf("By default, throttling and service specific errors are retried for %d seconds, and other errors for %d seconds.", int(longRetryTime.Seconds()), int(shortRetryTime.Seconds())),
+		"retry_base_backoff_seconds": "(Optional) The base of the quadratic backoff between retries, in seconds. The n-th retry waits n^2 times this duration.",
+		"retry_max_backoff_seconds":  "(Optional) The maximum wait between retries, in seconds. By default, the wait is only limited by the retry duration.",
+		"retry_jitter_percent":       "(Optional) The percentage by which each wait between retries is randomly shortened or lengthened, so that concurrent requests don't retry at the same time.",
+		"retryable_status_codes":     "(Optional) Additional HTTP status codes that are retried, such as 404 or 409.",
+		"retryable_error_codes":      "(Optional) Additional service error codes that are retried, such as 'TooManyRequests' or 'IncorrectState'.",
+		"endpoints":                  "(Optional) Endpoints that replace the default endpoints of the services, such as private endpoints or local mock servers (e.g. objectstorage = \"https://objectstorage.example.com\").",
+		"ca_bundle_path":             "(Optional) The path to a PEM bundle of additional certificate authorities to trust, for endpoints with certificates that are not signed by a public authority.",
+		"client_certificate_path":    "(Optional) The path to a PEM client certificate, for endpoints that require mutual TLS. Requires client_private_key_path.",
+		"client_private_key_path":    "(Optional) The path to the PEM private key of the client certificate. Requires client_certificate_path.",
+		"http_trace":                 "(Optional) Trace the method, URL, status, opc-request-id, attempt number, duration, headers and JSON bodies of every request to the Terraform log. Secrets are redacted.",
+		"http_trace_file":            "(Optional) The path to a JSON-lines file to append the traced requests to, instead of the Terraform log. Setting it enables http_trace.",
 	}
 }
 
@@ -75,7 +94,7 @@ func Provider(configfn schema.ConfigureFunc) terraform.ResourceProvider {
 	return &schema.Provider{
 		DataSourcesMap: dataSourcesMap(),
 		Schema:         schemaMap(),
-		ResourcesMap:   resourcesMap(),
+		ResourcesMap:   resourcesMapWithRetryBlock(),
 		ConfigureFunc:  configfn,
 	}
 }
@@ -132,10 +151,22 @@ func schemaMap() map[string]*schema.Schema {
 		},
 		"region": {
 			Type:        schema.TypeString,
-			Required:    true,
+			Optional:    true,
 			Description: descriptions["region"],
 			DefaultFunc: schema.EnvDefaultFunc("OCI_REGION", nil),
 		},
+		"config_file_path": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions["config_file_path"],
+			DefaultFunc: schema.EnvDefaultFunc("OCI_CONFIG_FILE", nil),
+		},
+		"config_file_profile": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions["config_file_profile"],
+			DefaultFunc: schema.EnvDefaultFunc("OCI_CLI_PROFILE", nil),
+		},
 		"disable_auto_retries": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -143,12 +174,131 @@ func schemaMap() map[string]*schema.Schema {
 			Description: descriptions["disable_auto_retries"],
 			DefaultFunc: schema.EnvDefaultFunc("OCI_DISABLE_AUTO_RETRIES", nil),
 		},
+		"retry_duration_seconds": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions["retry_duration_seconds"],
+			ValidateFunc: validation.IntBetween(1, 24*3600),
+		},
+		"retry_base_backoff_seconds": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      int(defaultBaseBackoff.Seconds()),
+			Description:  descriptions["retry_base_backoff_seconds"],
+			ValidateFunc: validation.IntBetween(1, 3600),
+		},
+		"retry_max_backoff_seconds": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions["retry_max_backoff_seconds"],
+			ValidateFunc: validation.IntBetween(1, 3600),
+		},
+		"retry_jitter_percent": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions["retry_jitter_percent"],
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+		"retryable_status_codes": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: descriptions["retryable_status_codes"],
+			Elem: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(100, 599),
+			},
+		},
+		"retryable_error_codes": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: descriptions["retryable_error_codes"],
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"endpoints": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: descriptions["endpoints"],
+			Elem: &schema.Resource{
+				Schema: endpointsSchema(),
+			},
+		},
+		// R1_CERT_LOCATION is the CA bundle of the R1 region
+		"ca_bundle_path": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions["ca_bundle_path"],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{"OCI_CA_BUNDLE_PATH", r1CertLocationEnv}, nil),
+		},
+		"client_certificate_path": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions["client_certificate_path"],
+			DefaultFunc: schema.EnvDefaultFunc("OCI_CLIENT_CERTIFICATE_PATH", nil),
+		},
+		"client_private_key_path": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions["client_private_key_path"],
+			DefaultFunc: schema.EnvDefaultFunc("OCI_CLIENT_PRIVATE_KEY_PATH", nil),
+		},
+		"http_trace": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: descriptions["http_trace"],
+			DefaultFunc: schema.EnvDefaultFunc("OCI_HTTP_TRACE", false),
+		},
+		"http_trace_file": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions["http_trace_file"],
+			DefaultFunc: schema.EnvDefaultFunc("OCI_HTTP_TRACE_FILE", nil),
+		},
 	}
 }
 
+// endpointServices are the services whose endpoint can be overridden, named after their clients.
+var endpointServices = []string{
+	"audit",
+	"blockstorage",
+	"compute",
+	"containerengine",
+	"database",
+	"dns",
+	"email",
+	"filestorage",
+	"identity",
+	"loadbalancer",
+	"objectstorage",
+	"virtualnetwork",
+}
+
+func endpointsSchema() map[string]*schema.Schema {
+	result := map[string]*schema.Schema{}
+	for _, service := range endpointServices {
+		result[service] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateEndpoint,
+		}
+	}
+	return result
+}
+
+func validateEndpoint(v interface{}, k string) (ws []string, errors []error) {
+	endpoint, err := url.Parse(v.(string))
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		errors = append(errors, fmt.Errorf("%q must be an http or https URL, such as 'https://objectstorage.example.com', got '%s'", k, v))
+	}
+	return
+}
+
 func dataSourcesMap() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"oci_audit_configuration":                      ConfigurationDataSource(),
+		"oci_audit_events":                             AuditEventsDataSource(),
 		"oci_containerengine_clusters":                 ClustersDataSource(),
 		"oci_containerengine_cluster_option":           ClusterOptionDataSource(),
 		"oci_containerengine_node_pools":               NodePoolsDataSource(),
@@ -158,6 +308,7 @@ func dataSourcesMap() map[string]*schema.Resource {
 		"oci_containerengine_work_request_errors":      WorkRequestErrorsDataSource(),
 		"oci_containerengine_work_request_log_entries": WorkRequestLogEntriesDataSource(),
 		"oci_core_boot_volume_attachments":             BootVolumeAttachmentsDataSource(),
+		"oci_core_boot_volume_backups":                 BootVolumeBackupsDataSource(),
 		"oci_core_boot_volumes":                        BootVolumesDataSource(),
 		"oci_core_console_histories":                   ConsoleHistoriesDataSource(),
 		"oci_core_console_history_data":                ConsoleHistoryContentDataSource(),
@@ -212,6 +363,7 @@ func dataSourcesMap() map[string]*schema.Resource {
 		"oci_core_volume_groups":                       VolumeGroupsDataSource(),
 		"oci_core_volume_group_backups":                VolumeGroupBackupsDataSource(),
 		"oci_database_backups":                         BackupsDataSource(),
+		"oci_database_data_guard_associations":         DataGuardAssociationsDataSource(),
 		"oci_database_database":                        DatabaseDataSource(),
 		"oci_database_databases":                       DatabasesDataSource(),
 		"oci_database_db_home":                         DbHomeDataSource(),
@@ -262,6 +414,7 @@ func dataSourcesMap() map[string]*schema.Resource {
 		"oci_load_balancer_backendsets":                BackendSetsDataSource(),
 		"oci_load_balancer_certificates":               CertificatesDataSource(),
 		"oci_load_balancer_health":                     LoadBalancerHealthDataSource(),
+		"oci_load_balancer_load_balancer_healths":      LoadBalancerHealthsDataSource(),
 		"oci_load_balancer_hostnames":                  HostnamesDataSource(),
 		"oci_load_balancer_policies":                   LoadBalancerPoliciesDataSource(),
 		"oci_load_balancer_protocols":                  LoadBalancerProtocolsDataSource(),
@@ -270,8 +423,11 @@ func dataSourcesMap() map[string]*schema.Resource {
 		"oci_load_balancers":                           LoadBalancersDataSource(),
 		"oci_load_balancer_path_route_sets":            PathRouteSetsDataSource(),
 		"oci_objectstorage_bucket_summaries":           BucketsDataSource(),
+		"oci_objectstorage_multipart_upload_parts":     MultipartUploadPartsDataSource(),
+		"oci_objectstorage_multipart_uploads":          MultipartUploadsDataSource(),
 		"oci_objectstorage_namespace":                  NamespaceDataSource(),
 		"oci_objectstorage_namespace_metadata":         NamespaceMetadataDataSource(),
+		"oci_objectstorage_object":                     ObjectDataSource(),
 		"oci_objectstorage_object_head":                ObjectHeadDataSource(),
 		"oci_objectstorage_objects":                    ObjectsDataSource(),
 		"oci_objectstorage_preauthrequest":             PreauthenticatedRequestDataSource(),
@@ -281,84 +437,111 @@ func dataSourcesMap() map[string]*schema.Resource {
 
 func resourcesMap() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"oci_audit_configuration":                  ConfigurationResource(),
-		"oci_containerengine_cluster":              ClusterResource(),
-		"oci_containerengine_node_pool":            NodePoolResource(),
-		"oci_core_console_history":                 ConsoleHistoryResource(),
-		"oci_core_cpe":                             CpeResource(),
-		"oci_core_cross_connect":                   CrossConnectResource(),
-		"oci_core_cross_connect_group":             CrossConnectGroupResource(),
-		"oci_core_default_dhcp_options":            DefaultDhcpOptionsResource(),
-		"oci_core_dhcp_options":                    DhcpOptionsResource(),
-		"oci_core_drg":                             DrgResource(),
-		"oci_core_drg_attachment":                  DrgAttachmentResource(),
-		"oci_core_image":                           ImageResource(),
-		"oci_core_instance":                        InstanceResource(),
-		"oci_core_instance_console_connection":     InstanceConsoleConnectionResource(),
-		"oci_core_internet_gateway":                InternetGatewayResource(),
-		"oci_core_ipsec":                           IpSecConnectionResource(),
-		"oci_core_local_peering_gateway":           LocalPeeringGatewayResource(),
-		"oci_core_private_ip":                      PrivateIpResource(),
-		"oci_core_public_ip":                       PublicIpResource(),
-		"oci_core_default_route_table":             DefaultRouteTableResource(),
-		"oci_core_route_table":                     RouteTableResource(),
-		"oci_core_remote_peering_connection":       RemotePeeringConnectionResource(),
-		"oci_core_default_security_list":           DefaultSecurityListResource(),
-		"oci_core_security_list":                   SecurityListResource(),
-		"oci_core_service_gateway":                 ServiceGatewayResource(),
-		"oci_core_subnet":                          SubnetResource(),
-		"oci_core_virtual_circuit":                 VirtualCircuitResource(),
-		"oci_core_virtual_network":                 VcnResource(), //This is a legacy name for VCN, removing it can cause breaking changes
-		"oci_core_vcn":                             VcnResource(),
-		"oci_core_vnic_attachment":                 VnicAttachmentResource(),
-		"oci_core_volume":                          VolumeResource(),
-		"oci_core_volume_group":                    VolumeGroupResource(),
-		"oci_core_volume_group_backup":             VolumeGroupBackupResource(),
-		"oci_core_volume_attachment":               VolumeAttachmentResource(),
-		"oci_core_volume_backup":                   VolumeBackupResource(),
-		"oci_core_volume_backup_policy_assignment": VolumeBackupPolicyAssignmentResource(),
+		"oci_audit_configuration":                     ConfigurationResource(),
+		"oci_containerengine_cluster":                 ClusterResource(),
+		"oci_containerengine_node_pool":               NodePoolResource(),
+		"oci_core_boot_volume":                        BootVolumeResource(),
+		"oci_core_boot_volume_attachment":             BootVolumeAttachmentResource(),
+		"oci_core_boot_volume_backup":                 BootVolumeBackupResource(),
+		"oci_core_console_history":                    ConsoleHistoryResource(),
+		"oci_core_cpe":                                CpeResource(),
+		"oci_core_cross_connect":                      CrossConnectResource(),
+		"oci_core_cross_connect_group":                CrossConnectGroupResource(),
+		"oci_core_default_dhcp_options":               DefaultDhcpOptionsResource(),
+		"oci_core_dhcp_options":                       DhcpOptionsResource(),
+		"oci_core_drg":                                DrgResource(),
+		"oci_core_drg_attachment":                     DrgAttachmentResource(),
+		"oci_core_image":                              ImageResource(),
+		"oci_core_image_export":                       ImageExportResource(),
+		"oci_core_instance":                           InstanceResource(),
+		"oci_core_instance_action":                    InstanceActionResource(),
+		"oci_core_instance_console_connection":        InstanceConsoleConnectionResource(),
+		"oci_core_internet_gateway":                   InternetGatewayResource(),
+		"oci_core_ipsec":                              IpSecConnectionResource(),
+		"oci_core_local_peering_gateway":              LocalPeeringGatewayResource(),
+		"oci_core_private_ip":                         PrivateIpResource(),
+		"oci_core_public_ip":                          PublicIpResource(),
+		"oci_core_default_route_table":                DefaultRouteTableResource(),
+		"oci_core_route_table":                        RouteTableResource(),
+		"oci_core_remote_peering_connection":          RemotePeeringConnectionResource(),
+		"oci_core_default_security_list":              DefaultSecurityListResource(),
+		"oci_core_security_list":                      SecurityListResource(),
+		"oci_core_service_gateway":                    ServiceGatewayResource(),
+		"oci_core_service_gateway_service_attachment": ServiceGatewayServiceAttachmentResource(),
+		"oci_core_subnet":                             SubnetResource(),
+		"oci_core_virtual_circuit":                    VirtualCircuitResource(),
+		"oci_core_virtual_network":                    VcnResource(), //This is a legacy name for VCN, removing it can cause breaking changes
+		"oci_core_vcn":                                VcnResource(),
+		"oci_core_vnic_attachment":                    VnicAttachmentResource(),
+		"oci_core_volume":                             VolumeResource(),
+		"oci_core_volume_group":                       VolumeGroupResource(),
+		"oci_core_volume_group_backup":                VolumeGroupBackupResource(),
+		"oci_core_volume_attachment":                  VolumeAttachmentResource(),
+		"oci_core_volume_backup":                      VolumeBackupResource(),
+		"oci_core_volume_backup_policy_assignment":    VolumeBackupPolicyAssignmentResource(),
 		//"oci_database_db_home":                     DbHomeResource(),
-		"oci_database_db_system":               DbSystemResource(),
-		"oci_database_backup":                  BackupResource(),
-		"oci_dns_record":                       RecordResource(),
-		"oci_dns_zone":                         ZoneResource(),
-		"oci_email_sender":                     SenderResource(),
-		"oci_email_suppression":                SuppressionResource(),
-		"oci_file_storage_export":              ExportResource(),
-		"oci_file_storage_export_set":          ExportSetResource(),
-		"oci_file_storage_file_system":         FileSystemResource(),
-		"oci_file_storage_mount_target":        MountTargetResource(),
-		"oci_file_storage_snapshot":            SnapshotResource(),
-		"oci_identity_api_key":                 ApiKeyResource(),
-		"oci_identity_auth_token":              AuthTokenResource(),
-		"oci_identity_compartment":             CompartmentResource(),
-		"oci_identity_customer_secret_key":     CustomerSecretKeyResource(),
-		"oci_identity_dynamic_group":           DynamicGroupResource(),
-		"oci_identity_group":                   GroupResource(),
-		"oci_identity_identity_provider":       IdentityProviderResource(),
-		"oci_identity_idp_group_mapping":       IdpGroupMappingResource(),
-		"oci_identity_policy":                  PolicyResource(),
-		"oci_identity_smtp_credential":         SmtpCredentialResource(),
-		"oci_identity_swift_password":          SwiftPasswordResource(),
-		"oci_identity_tag_namespace":           TagNamespaceResource(),
-		"oci_identity_tag":                     TagResource(),
-		"oci_identity_ui_password":             UiPasswordResource(),
-		"oci_identity_user":                    UserResource(),
-		"oci_identity_user_group_membership":   UserGroupMembershipResource(),
-		"oci_load_balancer":                    LoadBalancerResource(),
-		"oci_load_balancer_load_balancer":      LoadBalancerResource(),
-		"oci_load_balancer_backend":            BackendResource(),
-		"oci_load_balancer_backend_set":        BackendSetResource(),
-		"oci_load_balancer_backendset":         BackendSetResource(),
-		"oci_load_balancer_certificate":        CertificateResource(),
-		"oci_load_balancer_listener":           ListenerResource(),
-		"oci_load_balancer_hostname":           HostnameResource(),
-		"oci_load_balancer_path_route_set":     PathRouteSetResource(),
-		"oci_objectstorage_bucket":             BucketResource(),
-		"oci_objectstorage_object":             ObjectResource(),
-		"oci_objectstorage_namespace_metadata": NamespaceMetadataResource(),
-		"oci_objectstorage_preauthrequest":     PreauthenticatedRequestResource(),
+		"oci_database_data_guard_association":         DataGuardAssociationResource(),
+		"oci_database_database":                       DatabaseResource(),
+		"oci_database_database_restore":               DatabaseRestoreResource(),
+		"oci_database_db_home_patch_action":           DbHomePatchActionResource(),
+		"oci_database_db_node":                        DbNodeResource(),
+		"oci_database_db_system":                      DbSystemResource(),
+		"oci_database_backup":                         BackupResource(),
+		"oci_dns_domain_records":                      DomainRecordsResource(),
+		"oci_dns_record":                              RecordResource(),
+		"oci_dns_rrset":                               RRSetResource(),
+		"oci_dns_zone":                                ZoneResource(),
+		"oci_email_sender":                            SenderResource(),
+		"oci_email_suppression":                       SuppressionResource(),
+		"oci_file_storage_export":                     ExportResource(),
+		"oci_file_storage_export_set":                 ExportSetResource(),
+		"oci_file_storage_file_system":                FileSystemResource(),
+		"oci_file_storage_mount_target":               MountTargetResource(),
+		"oci_file_storage_snapshot":                   SnapshotResource(),
+		"oci_identity_api_key":                        ApiKeyResource(),
+		"oci_identity_auth_token":                     AuthTokenResource(),
+		"oci_identity_compartment":                    CompartmentResource(),
+		"oci_identity_customer_secret_key":            CustomerSecretKeyResource(),
+		"oci_identity_dynamic_group":                  DynamicGroupResource(),
+		"oci_identity_group":                          GroupResource(),
+		"oci_identity_identity_provider":              IdentityProviderResource(),
+		"oci_identity_idp_group_mapping":              IdpGroupMappingResource(),
+		"oci_identity_policy":                         PolicyResource(),
+		"oci_identity_smtp_credential":                SmtpCredentialResource(),
+		"oci_identity_swift_password":                 SwiftPasswordResource(),
+		"oci_identity_tag_namespace":                  TagNamespaceResource(),
+		"oci_identity_tag":                            TagResource(),
+		"oci_identity_ui_password":                    UiPasswordResource(),
+		"oci_identity_user":                           UserResource(),
+		"oci_identity_user_group_membership":          UserGroupMembershipResource(),
+		"oci_load_balancer":                           LoadBalancerResource(),
+		"oci_load_balancer_load_balancer":             LoadBalancerResource(),
+		"oci_load_balancer_backend":                   BackendResource(),
+		"oci_load_balancer_backend_set":               BackendSetResource(),
+		"oci_load_balancer_backendset":                BackendSetResource(),
+		"oci_load_balancer_backendset_health_checker": BackendSetHealthCheckerResource(),
+		"oci_load_balancer_certificate":               CertificateResource(),
+		"oci_load_balancer_listener":                  ListenerResource(),
+		"oci_load_balancer_hostname":                  HostnameResource(),
+		"oci_load_balancer_path_route_set":            PathRouteSetResource(),
+		"oci_objectstorage_bucket":                    BucketResource(),
+		"oci_objectstorage_object":                    ObjectResource(),
+		"oci_objectstorage_object_restore":            ObjectRestoreResource(),
+		"oci_objectstorage_object_set":                ObjectSetResource(),
+		"oci_objectstorage_multipart_upload_cleanup":  MultipartUploadCleanupResource(),
+		"oci_objectstorage_namespace_metadata":        NamespaceMetadataResource(),
+		"oci_objectstorage_preauthrequest":            PreauthenticatedRequestResource(),
+	}
+}
+
+// resourcesMapWithRetryBlock returns the resources with the `retry` block that overrides the retry arguments of the
+// provider.
+func resourcesMapWithRetryBlock() map[string]*schema.Resource {
+	resources := resourcesMap()
+	for _, resource := range resources {
+		addRetryBlock(resource)
 	}
+	return resources
 }
 
 func getEnvSetting(s string, dv string) string {
@@ -396,12 +579,22 @@ func validateConfigForAPIKeyAuth(d *schema.ResourceData) error {
 }
 
 func ProviderConfig(d *schema.ResourceData) (clients interface{}, err error) {
+	return providerConfig(d, nil)
+}
+
+// providerConfig configures the clients of a provider. The acceptance tests pass the cassette that records or replays
+// their requests, and the clients fast-forward their waits while it replays them.
+func providerConfig(d *schema.ResourceData, cassette *httpCassette) (clients interface{}, err error) {
 	clients = &OracleClients{}
-	disableAutoRetries = d.Get("disable_auto_retries").(bool)
 	auth := strings.ToLower(d.Get("auth").(string))
 
 	userAgent := fmt.Sprintf(userAgentFormatter, oci_common.Version(), runtime.Version(), runtime.GOOS, runtime.GOARCH, terraform.VersionString(), Version)
 
+	tlsConfig, err := tlsConfigFromProvider(d)
+	if err != nil {
+		return nil, err
+	}
+
 	httpClient := &http.Client{
 		Timeout: defaultRequestTimeout,
 		Transport: &http.Transport{
@@ -409,17 +602,25 @@ func ProviderConfig(d *schema.ResourceData) (clients interface{}, err error) {
 				Timeout: defaultConnectionTimeout,
 			}).DialContext,
 			TLSHandshakeTimeout: defaultTLSHandshakeTimeout,
-			TLSClientConfig:     &tls.Config{MinVersion: tls.VersionTLS12},
+			TLSClientConfig:     tlsConfig,
 			Proxy:               http.ProxyFromEnvironment,
 		},
 	}
 
-	var configProviders []oci_common.ConfigurationProvider
+	var configProviders credentialSourcesConfigProvider
+
+	configFileProvider, err := configFileConfigProvider(d)
+	if err != nil {
+		return nil, err
+	}
 
 	switch auth {
 	case strings.ToLower(authAPIKeySetting):
-		if err := validateConfigForAPIKeyAuth(d); err != nil {
-			return nil, err
+		// The credentials that are missing from the provider block may be read from the config file profile
+		if configFileProvider == nil {
+			if err := validateConfigForAPIKeyAuth(d); err != nil {
+				return nil, err
+			}
 		}
 	case strings.ToLower(authInstancePrincipalSetting):
 		region, ok := d.GetOkExists("region")
@@ -430,32 +631,48 @@ func ProviderConfig(d *schema.ResourceData) (clients interface{}, err error) {
 		if err != nil {
 			return nil, err
 		}
-		configProviders = append(configProviders, cfg)
+		configProviders = append(configProviders, configProviderSource{"instance principal", cfg})
 	default:
 		return nil, fmt.Errorf("auth must be one of '%s' or '%s'", authAPIKeySetting, authInstancePrincipalSetting)
 	}
 
-	configProviders = append(configProviders, ResourceDataConfigProvider{d})
+	configProviders = append(configProviders, configProviderSource{"the provider block", ResourceDataConfigProvider{d}})
+
+	if configFileProvider != nil {
+		configProviders = append(configProviders, *configFileProvider)
+	}
 
 	// TODO: DefaultConfigProvider will return us a composingConfigurationProvider that reads from SDK config files,
 	// and then from the environment variables ("TF_VAR" prefix). References to "TF_VAR" prefix should be removed from
 	// the SDK, since it's Terraform specific. When that happens, we need to update this to pass in the right prefix.
-	configProviders = append(configProviders, oci_common.DefaultConfigProvider())
+	configProviders = append(configProviders, configProviderSource{"the default config file or TF_VAR environment variables", oci_common.DefaultConfigProvider()})
+
+	configProviders.logCredentialSources()
 
-	officialSdkConfigProvider, err := oci_common.ComposingConfigurationProvider(configProviders)
+	var dispatcher oci_common.HTTPRequestDispatcher = httpClient
+	if cassette != nil {
+		dispatcher = cassette.dispatcher(httpClient)
+	}
+	tracer, err := httpTracerFromProvider(d)
 	if err != nil {
 		return nil, err
 	}
+	if tracer != nil {
+		dispatcher = tracingDispatcher{HTTPRequestDispatcher: dispatcher, tracer: tracer}
+	}
 
-	err = setGoSDKClients(clients.(*OracleClients), officialSdkConfigProvider, httpClient, userAgent)
+	err = setGoSDKClients(clients.(*OracleClients), configProviders, dispatcher, userAgent, endpointsFromProvider(d))
 	if err != nil {
 		return nil, err
 	}
 
-	return clients, nil
+	// The retry settings are attached to the clients, so that aliased providers with different settings don't share them
+	settings := retrySettingsFromProvider(d)
+	settings.fastForward = cassette != nil && cassette.replaying
+	return clients.(*OracleClients).withRetrySettings(settings), nil
 }
 
-func setGoSDKClients(clients *OracleClients, officialSdkConfigProvider oci_common.ConfigurationProvider, httpClient *http.Client, userAgent string) (err error) {
+func setGoSDKClients(clients *OracleClients, officialSdkConfigProvider oci_common.ConfigurationProvider, httpClient oci_common.HTTPRequestDispatcher, userAgent string, endpoints map[string]string) (err error) {
 	// Official Go SDK clients:
 	auditClient, err := oci_audit.NewAuditClientWithConfigurationProvider(officialSdkConfigProvider)
 	if err != nil {
@@ -529,77 +746,66 @@ func setGoSDKClients(clients *OracleClients, officialSdkConfigProvider oci_commo
 		oboTokenProvider = oci_common.NewEmptyOboTokenProvider()
 	}
 
-	configureClient := func(client *oci_common.BaseClient) error {
+	// The clients share the HTTP client, so that they share its transport, proxy and TLS settings
+	configureClient := func(client *oci_common.BaseClient, service string) error {
 		client.HTTPClient = httpClient
 		client.UserAgent = userAgent
 		client.Obo = oboTokenProvider
 
-		// R1 Support
-		if region, err := officialSdkConfigProvider.Region(); err == nil && strings.ToLower(region) == "r1" {
-			service := strings.Split(client.Host, ".")[0]
-			client.Host = fmt.Sprintf("%s.r1.oracleiaas.com", service)
-
-			pool := x509.NewCertPool()
-			//readCertPem reads the pem files to a []byte
-			cert, err := readCertPem()
-			if err != nil {
-				return err
-			}
-			if ok := pool.AppendCertsFromPEM(cert); !ok {
-				return fmt.Errorf("failed to append R1 cert to the cert pool")
-			}
-			//install the certificates to the client
-			if h, ok := client.HTTPClient.(*http.Client); ok {
-				tr := &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}
-				h.Transport = tr
-			} else {
-				return fmt.Errorf("the client dispatcher is not of http.Client type. can not patch the tls config")
-			}
+		if endpoint, ok := endpoints[service]; ok {
+			client.Host = endpoint
+		} else if region, err := officialSdkConfigProvider.Region(); err == nil && strings.ToLower(region) == "r1" {
+			// R1 Support
+			client.Host = fmt.Sprintf("%s.r1.oracleiaas.com", strings.Split(client.Host, ".")[0])
 		}
 		return nil
 	}
 
-	err = configureClient(&auditClient.BaseClient)
+	err = configureClient(&auditClient.BaseClient, "audit")
 	if err != nil {
 		return
 	}
-	err = configureClient(&blockstorageClient.BaseClient)
+	err = configureClient(&blockstorageClient.BaseClient, "blockstorage")
 	if err != nil {
 		return
 	}
-	err = configureClient(&computeClient.BaseClient)
+	err = configureClient(&computeClient.BaseClient, "compute")
 	if err != nil {
 		return
 	}
-	err = configureClient(&databaseClient.BaseClient)
+	err = configureClient(&databaseClient.BaseClient, "database")
 	if err != nil {
 		return
 	}
-	err = configureClient(&fileStorageClient.BaseClient)
+	err = configureClient(&dnsClient.BaseClient, "dns")
 	if err != nil {
 		return
 	}
-	err = configureClient(&identityClient.BaseClient)
+	err = configureClient(&fileStorageClient.BaseClient, "filestorage")
 	if err != nil {
 		return
 	}
-	err = configureClient(&loadBalancerClient.BaseClient)
+	err = configureClient(&identityClient.BaseClient, "identity")
 	if err != nil {
 		return
 	}
-	err = configureClient(&objectStorageClient.BaseClient)
+	err = configureClient(&loadBalancerClient.BaseClient, "loadbalancer")
 	if err != nil {
 		return
 	}
-	err = configureClient(&virtualNetworkClient.BaseClient)
+	err = configureClient(&objectStorageClient.BaseClient, "objectstorage")
 	if err != nil {
 		return
 	}
-	err = configureClient(&emailClient.BaseClient)
+	err = configureClient(&virtualNetworkClient.BaseClient, "virtualnetwork")
 	if err != nil {
 		return
 	}
-	err = configureClient(&containerEngineClient.BaseClient)
+	err = configureClient(&emailClient.BaseClient, "email")
+	if err != nil {
+		return
+	}
+	err = configureClient(&containerEngineClient.BaseClient, "containerengine")
 	if err != nil {
 		return
 	}
@@ -633,16 +839,13 @@ type OracleClients struct {
 	fileStorageClient     *oci_file_storage.FileStorageClient
 	emailClient           *oci_email.EmailClient
 	containerEngineClient *oci_containerengine.ContainerEngineClient
+	retrySettings         *retrySettings
 }
 
 type ResourceDataConfigProvider struct {
 	D *schema.ResourceData
 }
 
-// TODO: The error messages returned by following methods get swallowed up by the ComposingConfigurationProvider,
-// since it only checks whether an error exists or not.
-// The ComposingConfigurationProvider in SDK should log the errors as debug statements instead.
-
 func (p ResourceDataConfigProvider) TenancyOCID() (string, error) {
 	if tenancyOCID, ok := p.D.GetOkExists("tenancy_ocid"); ok {
 		return tenancyOCID.(string), nil
@@ -711,12 +914,199 @@ func (p ResourceDataConfigProvider) PrivateRSAKey() (key *rsa.PrivateKey, err er
 	return nil, fmt.Errorf("can not get private_key or private_key_path from Terraform configuration")
 }
 
-func readCertPem() (file []byte, err error) {
-	r1CertLoc := getEnvSetting(r1CertLocationEnv, "")
-	if r1CertLoc == "" {
-		err = fmt.Errorf("the R1 Certificate Location must be specified in the environment variable %s", r1CertLocationEnv)
-		return
+// configFileConfigProvider returns the source of the profile of the config file set in the provider block, or nil
+// if neither config_file_path nor config_file_profile are set.
+func configFileConfigProvider(d *schema.ResourceData) (*configProviderSource, error) {
+	path, hasPath := d.GetOk("config_file_path")
+	profile, hasProfile := d.GetOk("config_file_profile")
+	if !hasPath && !hasProfile {
+		return nil, nil
+	}
+
+	if !hasPath {
+		path = defaultConfigFilePath
 	}
-	file, err = ioutil.ReadFile(r1CertLoc)
+	if !hasProfile {
+		profile = defaultConfigFileProfile
+	}
+
+	password := ""
+	if privateKeyPassword, hasPrivateKeyPassword := d.GetOkExists("private_key_password"); hasPrivateKeyPassword {
+		password = privateKeyPassword.(string)
+	}
+
+	cfg, err := oci_common.ConfigurationProviderFromFileWithProfile(path.(string), profile.(string), password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &configProviderSource{fmt.Sprintf("profile '%s' of config file '%s'", profile, path), cfg}, nil
+}
+
+// configProviderSource is a named source of credentials, so that the source of each credential can be reported.
+type configProviderSource struct {
+	name string
+	oci_common.ConfigurationProvider
+}
+
+// credentialSourcesConfigProvider reads each credential from the first source that supplies it. Unlike the
+// ComposingConfigurationProvider of the SDK, the error of every source is returned when none supplies a credential.
+type credentialSourcesConfigProvider []configProviderSource
+
+func (p credentialSourcesConfigProvider) getString(name string, get func(oci_common.ConfigurationProvider) (string, error)) (value string, source string, err error) {
+	errs := []string{}
+	for _, provider := range p {
+		if value, err = get(provider); err == nil {
+			return value, provider.name, nil
+		}
+		errs = append(errs, fmt.Sprintf("%s: %s", provider.name, err))
+	}
+	return "", "", fmt.Errorf("can not get %s from any source (%s)", name, strings.Join(errs, "; "))
+}
+
+func (p credentialSourcesConfigProvider) getPrivateRSAKey() (key *rsa.PrivateKey, source string, err error) {
+	errs := []string{}
+	for _, provider := range p {
+		if key, err = provider.PrivateRSAKey(); err == nil {
+			return key, provider.name, nil
+		}
+		errs = append(errs, fmt.Sprintf("%s: %s", provider.name, err))
+	}
+	return nil, "", fmt.Errorf("can not get private key from any source (%s)", strings.Join(errs, "; "))
+}
+
+func (p credentialSourcesConfigProvider) TenancyOCID() (string, error) {
+	value, _, err := p.getString("tenancy_ocid", oci_common.ConfigurationProvider.TenancyOCID)
+	return value, err
+}
+
+func (p credentialSourcesConfigProvider) UserOCID() (string, error) {
+	value, _, err := p.getString("user_ocid", oci_common.ConfigurationProvider.UserOCID)
+	return value, err
+}
+
+func (p credentialSourcesConfigProvider) KeyFingerprint() (string, error) {
+	value, _, err := p.getString("fingerprint", oci_common.ConfigurationProvider.KeyFingerprint)
+	return value, err
+}
+
+func (p credentialSourcesConfigProvider) Region() (string, error) {
+	value, _, err := p.getString("region", oci_common.ConfigurationProvider.Region)
+	return value, err
+}
+
+// KeyID is composed of the credentials of the sources that supply them, which may differ from the key ID of any single
+// source.
+func (p credentialSourcesConfigProvider) KeyID() (string, error) {
+	tenancy, err := p.TenancyOCID()
+	if err != nil {
+		return "", err
+	}
+
+	user, err := p.UserOCID()
+	if err != nil {
+		return "", err
+	}
+
+	fingerprint, err := p.KeyFingerprint()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/%s/%s", tenancy, user, fingerprint), nil
+}
+
+func (p credentialSourcesConfigProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	key, _, err := p.getPrivateRSAKey()
+	return key, err
+}
+
+// credentialSources returns the name of the source that supplies each credential, and the errors of all the sources
+// for the credentials that none supplies.
+func (p credentialSourcesConfigProvider) credentialSources() (sources map[string]string, errs map[string]error) {
+	sources, errs = map[string]string{}, map[string]error{}
+	getters := map[string]func(oci_common.ConfigurationProvider) (string, error){
+		"tenancy_ocid": oci_common.ConfigurationProvider.TenancyOCID,
+		"user_ocid":    oci_common.ConfigurationProvider.UserOCID,
+		"fingerprint":  oci_common.ConfigurationProvider.KeyFingerprint,
+		"region":       oci_common.ConfigurationProvider.Region,
+	}
+	for name, get := range getters {
+		if _, source, err := p.getString(name, get); err != nil {
+			errs[name] = err
+		} else {
+			sources[name] = source
+		}
+	}
+
+	if _, source, err := p.getPrivateRSAKey(); err != nil {
+		errs["private_key"] = err
+	} else {
+		sources["private_key"] = source
+	}
+
 	return
 }
+
+func (p credentialSourcesConfigProvider) logCredentialSources() {
+	sources, errs := p.credentialSources()
+	for _, name := range []string{"tenancy_ocid", "user_ocid", "fingerprint", "private_key", "region"} {
+		if source, ok := sources[name]; ok {
+			log.Printf("[DEBUG] %s supplied by %s", name, source)
+		} else {
+			log.Printf("[DEBUG] %s", errs[name])
+		}
+	}
+}
+
+// endpointsFromProvider returns the endpoints of the endpoints block, by service.
+func endpointsFromProvider(d *schema.ResourceData) map[string]string {
+	result := map[string]string{}
+	if endpoints, ok := d.GetOk("endpoints"); ok {
+		if raw, ok := endpoints.([]interface{})[0].(map[string]interface{}); ok {
+			for service, endpoint := range raw {
+				if endpoint.(string) != "" {
+					result[service] = endpoint.(string)
+				}
+			}
+		}
+	}
+	return result
+}
+
+// tlsConfigFromProvider returns the TLS configuration of the clients, which trusts the CA bundle and presents the
+// client certificate of the provider when they are set.
+func tlsConfigFromProvider(d *schema.ResourceData) (*tls.Config, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if caBundlePath, ok := d.GetOk("ca_bundle_path"); ok {
+		pem, err := ioutil.ReadFile(caBundlePath.(string))
+		if err != nil {
+			return nil, fmt.Errorf("can not read the CA bundle from: '%s', Error: %q", caBundlePath, err)
+		}
+		// The bundle is added to the system certificate authorities, so that the public endpoints can still be reached
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if ok := pool.AppendCertsFromPEM(pem); !ok {
+			return nil, fmt.Errorf("no certificate found in the CA bundle '%s'", caBundlePath)
+		}
+		config.RootCAs = pool
+	}
+
+	certificatePath, hasCertificatePath := d.GetOk("client_certificate_path")
+	privateKeyPath, hasPrivateKeyPath := d.GetOk("client_private_key_path")
+	if hasCertificatePath != hasPrivateKeyPath {
+		return nil, fmt.Errorf("client_certificate_path and client_private_key_path must be set together")
+	}
+	if hasCertificatePath {
+		certificate, err := tls.LoadX509KeyPair(certificatePath.(string), privateKeyPath.(string))
+		if err != nil {
+			return nil, fmt.Errorf("can not load the client certificate from: '%s', Error: %q", certificatePath, err)
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return config, nil
+}
@@ -3,23 +3,63 @@
 package provider
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"runtime"
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_core "github.com/oracle/oci-go-sdk/core"
 	"github.com/stretchr/testify/assert"
 )
 
 var testAccProvider *schema.Provider
 var testAccProviders map[string]terraform.ResourceProvider
 
+// httpReplaySettings are the placeholders that replace the settings of the tenancy in the fixtures of the tests, and
+// that the tests use when they replay their requests.
+var httpReplaySettings = map[string]string{
+	"tenancy_ocid":              "ocid1.tenancy.oc1..replay",
+	"user_ocid":                 "ocid1.user.oc1..replay",
+	"compartment_ocid":          "ocid1.compartment.oc1..replay",
+	"compartment_id_for_create": "ocid1.compartment.oc1..replaycreate",
+	"compartment_id_for_update": "ocid1.compartment.oc1..replayupdate",
+	"namespace":                 "replaynamespace",
+}
+
 func init() {
+	if os.Getenv(httpModeEnvironmentVariable) == httpModeReplay {
+		// The requests are not sent, so the tests run without a tenancy, and sign them with a test key
+		for setting, value := range httpReplaySettings {
+			os.Setenv("TF_VAR_"+setting, value)
+		}
+		os.Setenv("TF_VAR_fingerprint", testKeyFingerPrint)
+		os.Setenv("TF_VAR_private_key", testPrivateKey)
+		os.Setenv("TF_VAR_private_key_password", "password")
+		if getEnvSetting("region", "") == "" {
+			os.Setenv("TF_VAR_region", "us-phoenix-1")
+		}
+	}
+
 	testAccProvider = Provider(func(d *schema.ResourceData) (interface{}, error) {
 		return GetTestProvider(), nil
 	}).(*schema.Provider)
@@ -212,18 +252,101 @@ func GetTestProvider() *OracleClients {
 	d.Set("tenancy_ocid", getRequiredEnvSetting("tenancy_ocid"))
 	d.Set("user_ocid", getRequiredEnvSetting("user_ocid"))
 	d.Set("fingerprint", getRequiredEnvSetting("fingerprint"))
-	d.Set("private_key_path", getRequiredEnvSetting("private_key_path"))
 	d.Set("private_key_password", getEnvSetting("private_key_password", ""))
 	d.Set("private_key", getEnvSetting("private_key", ""))
+	if _, hasPrivateKey := d.GetOk("private_key"); !hasPrivateKey {
+		d.Set("private_key_path", getRequiredEnvSetting("private_key_path"))
+	}
 	d.Set("region", getEnvSetting("region", "us-phoenix-1"))
 
-	client, err := ProviderConfig(d)
+	client, err := providerConfig(d, testHTTPCassette)
 	if err != nil {
 		panic(err)
 	}
 	return client.(*OracleClients)
 }
 
+// testHTTPCassette records or replays the requests of the acceptance test that is running, if any. It is only set by
+// resourceTest, and used by the clients of the test provider, which are configured for each test.
+var testHTTPCassette *httpCassette
+
+// resourceTest runs an acceptance test with resource.Test. When OCI_TEST_HTTP_MODE is 'record', the requests of the test
+// are saved to its fixture in testdata/fixtures, and when it is 'replay', they are answered from its fixture instead,
+// without requiring TF_ACC.
+func resourceTest(t *testing.T, testCase resource.TestCase) {
+	mode := os.Getenv(httpModeEnvironmentVariable)
+	if mode == "" {
+		resource.Test(t, testCase)
+		return
+	}
+
+	path := filepath.Join("testdata", "fixtures", filepath.FromSlash(t.Name())+".json")
+	region := getRequiredEnvSetting("region")
+
+	var cassette *httpCassette
+	switch mode {
+	case httpModeRecord:
+		if _, err := httpRecordSubstitutions(); err != nil {
+			t.Fatal(err)
+		}
+		cassette = newRecordingHTTPCassette(region)
+		// The fixtures of the tests that fail are not saved
+		defer func() {
+			if t.Failed() {
+				return
+			}
+			substitutions, _ := httpRecordSubstitutions()
+			if err := cassette.save(path, substitutions); err != nil {
+				t.Errorf("Unable to save the fixture %s: %v", path, err)
+			}
+		}()
+	case httpModeReplay:
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			t.Skipf("No recorded requests in %s", path)
+		}
+		var err error
+		if cassette, err = loadHTTPCassette(path); err != nil {
+			t.Fatal(err)
+		}
+		if cassette.fixture.Region != region {
+			t.Skipf("The requests in %s were recorded in the region %s", path, cassette.fixture.Region)
+		}
+		// The requests are not sent, so the test runs without TF_ACC
+		testCase.IsUnitTest = true
+	default:
+		t.Fatalf("%s must be '%s' or '%s'", httpModeEnvironmentVariable, httpModeRecord, httpModeReplay)
+	}
+
+	testHTTPCassette = cassette
+	defer func() { testHTTPCassette = nil }()
+	resource.Test(t, testCase)
+}
+
+// httpRecordSubstitutions returns the placeholders of the settings of the tenancy, and of the timestamps of the tests,
+// by the values they replace in the fixtures.
+func httpRecordSubstitutions() (map[string]string, error) {
+	substitutions := map[string]string{}
+	settings := map[string]string{}
+	for setting, placeholder := range httpReplaySettings {
+		value := getEnvSetting(setting, "")
+		if value == "" {
+			continue
+		}
+		if other, ok := settings[value]; ok {
+			return nil, fmt.Errorf("the %s and %s settings must be different to record the requests of the tests", other, setting)
+		}
+		settings[value] = setting
+		substitutions[value] = placeholder
+	}
+
+	timestamps.Lock()
+	defer timestamps.Unlock()
+	for _, value := range timestamps.values {
+		substitutions[value] = replayTimestamp
+	}
+	return substitutions, nil
+}
+
 // This test runs the Provider sanity checks.
 func TestProvider(t *testing.T) {
 	// Real client for the sanity check. Makes this more of an acceptance test.
@@ -321,7 +444,7 @@ func providerConfigTest(t *testing.T, disableRetries bool, skipRequiredField boo
 		assert.NotNil(t, c.Obo)
 	}
 
-	assert.Exactly(t, disableAutoRetries, disableRetries)
+	assert.Exactly(t, oracleClient.retrySettings.disableAutoRetries, disableRetries)
 	testClient(&oracleClient.blockstorageClient.BaseClient)
 	testClient(&oracleClient.computeClient.BaseClient)
 	testClient(&oracleClient.databaseClient.BaseClient)
@@ -338,6 +461,207 @@ func TestProviderConfig(t *testing.T) {
 	providerConfigTest(t, true, false, "invalid-auth-setting")        // Invalid auth + disable auto-retries
 }
 
+// writeTestConfigFile writes an OCI CLI config file with a DEFAULT and an ADMIN profile, and returns its path.
+func writeTestConfigFile(t *testing.T, dir string) string {
+	keyPath := filepath.Join(dir, "key.pem")
+	if err := ioutil.WriteFile(keyPath, []byte(testPrivateKey), 0600); err != nil {
+		t.Fatalf("Unable to write the key file: %v", err)
+	}
+
+	configPath := filepath.Join(dir, "config")
+	config := fmt.Sprintf(`[DEFAULT]
+tenancy=%s
+user=%s
+fingerprint=%s
+key_file=%s
+region=us-phoenix-1
+
+[ADMIN]
+tenancy=%s
+user=ocid1.user.oc1..adminuser
+fingerprint=%s
+key_file=%s
+region=us-ashburn-1
+`, testTenancyOCID, testUserOCID, testKeyFingerPrint, keyPath, testTenancyOCID, testKeyFingerPrint, keyPath)
+	if err := ioutil.WriteFile(configPath, []byte(config), 0600); err != nil {
+		t.Fatalf("Unable to write the config file: %v", err)
+	}
+
+	return configPath
+}
+
+func TestProviderConfigFileProfile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config_file_profile")
+	if err != nil {
+		t.Fatalf("Unable to create a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	configPath := writeTestConfigFile(t, dir)
+
+	r := &schema.Resource{
+		Schema: schemaMap(),
+	}
+	d := r.Data(nil)
+	d.Set("auth", authAPIKeySetting)
+	d.Set("config_file_path", configPath)
+	d.Set("config_file_profile", "ADMIN")
+	d.Set("private_key_password", "password")
+	d.Set("user_ocid", testUserOCID)
+
+	// The credentials that are missing from the provider block are read from the profile
+	client, err := ProviderConfig(d)
+	assert.Nil(t, err)
+	oracleClient, ok := client.(*OracleClients)
+	assert.True(t, ok)
+	assert.Exactly(t, "identity.us-ashburn-1.oraclecloud.com", oracleClient.identityClient.Host)
+
+	configFileProvider, err := configFileConfigProvider(d)
+	assert.Nil(t, err)
+	configProviders := credentialSourcesConfigProvider{configProviderSource{"the provider block", ResourceDataConfigProvider{d}}, *configFileProvider}
+
+	// The arguments of the provider block take precedence over the profile
+	userOCID, err := configProviders.UserOCID()
+	assert.Nil(t, err)
+	assert.Exactly(t, testUserOCID, userOCID)
+
+	// The key ID that signs the requests is made of the same credentials, although the profile has no user
+	keyID, err := configProviders.KeyID()
+	assert.Nil(t, err)
+	assert.Exactly(t, fmt.Sprintf("%s/%s/%s", testTenancyOCID, testUserOCID, testKeyFingerPrint), keyID)
+
+	profileSource := fmt.Sprintf("profile 'ADMIN' of config file '%s'", configPath)
+	sources, errs := configProviders.credentialSources()
+	assert.Empty(t, errs)
+	assert.Exactly(t, map[string]string{
+		"tenancy_ocid": profileSource,
+		"user_ocid":    "the provider block",
+		"fingerprint":  profileSource,
+		"region":       profileSource,
+		"private_key":  profileSource,
+	}, sources)
+
+	// The profile defaults to DEFAULT
+	d.Set("config_file_profile", "")
+	configFileProvider, err = configFileConfigProvider(d)
+	assert.Nil(t, err)
+	region, err := configFileProvider.Region()
+	assert.Nil(t, err)
+	assert.Exactly(t, "us-phoenix-1", region)
+
+	// The errors of every source are reported when none supplies a credential
+	d.Set("config_file_profile", "MISSING")
+	configFileProvider, err = configFileConfigProvider(d)
+	assert.Nil(t, err)
+	configProviders = credentialSourcesConfigProvider{configProviderSource{"the provider block", ResourceDataConfigProvider{d}}, *configFileProvider}
+	_, err = configProviders.Region()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "can not get region from Terraform configuration")
+	assert.Contains(t, err.Error(), "profile 'MISSING'")
+}
+
+// writeTestClientCertificate writes a self-signed client certificate and its key, and returns their paths.
+func writeTestClientCertificate(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Unable to generate the key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Unable to create the certificate: %v", err)
+	}
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Unable to marshal the key: %v", err)
+	}
+
+	certificatePath, keyPath := filepath.Join(dir, "client.pem"), filepath.Join(dir, "client_key.pem")
+	ioutil.WriteFile(certificatePath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}), 0600)
+	ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600)
+	return certificatePath, keyPath
+}
+
+func TestProviderConfigEndpoints(t *testing.T) {
+	dir, err := ioutil.TempDir("", "endpoints")
+	if err != nil {
+		t.Fatalf("Unable to create a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// A local mock of the networking service that requires a client certificate
+	var clientCertificates int
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientCertificates = len(r.TLS.PeerCertificates)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "ocid1.vcn.oc1..test", "lifecycleState": "AVAILABLE"}`)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	caBundlePath := filepath.Join(dir, "ca.pem")
+	ioutil.WriteFile(caBundlePath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600)
+	certificatePath, keyPath := writeTestClientCertificate(t, dir)
+
+	r := &schema.Resource{
+		Schema: schemaMap(),
+	}
+	d := r.Data(nil)
+	d.Set("auth", authAPIKeySetting)
+	d.Set("tenancy_ocid", testTenancyOCID)
+	d.Set("user_ocid", testUserOCID)
+	d.Set("fingerprint", testKeyFingerPrint)
+	d.Set("private_key", testPrivateKey)
+	d.Set("private_key_password", "password")
+	d.Set("region", "us-phoenix-1")
+	d.Set("endpoints", []interface{}{map[string]interface{}{"virtualnetwork": server.URL}})
+	d.Set("ca_bundle_path", caBundlePath)
+	d.Set("client_certificate_path", certificatePath)
+	d.Set("client_private_key_path", keyPath)
+
+	client, err := ProviderConfig(d)
+	assert.Nil(t, err)
+	oracleClient := client.(*OracleClients)
+
+	// Only the overridden endpoints are changed
+	assert.Exactly(t, server.URL, oracleClient.virtualNetworkClient.Host)
+	assert.Exactly(t, "iaas.us-phoenix-1.oraclecloud.com", oracleClient.computeClient.Host)
+
+	// The proxy and timeouts of the transport are kept
+	transport := oracleClient.virtualNetworkClient.HTTPClient.(retrySettingsDispatcher).HTTPRequestDispatcher.(*http.Client).Transport.(*http.Transport)
+	assert.NotNil(t, transport.Proxy)
+	assert.Exactly(t, defaultTLSHandshakeTimeout, transport.TLSHandshakeTimeout)
+
+	response, err := oracleClient.virtualNetworkClient.GetVcn(context.Background(), oci_core.GetVcnRequest{VcnId: oci_common.String("ocid1.vcn.oc1..test")})
+	assert.Nil(t, err)
+	assert.Exactly(t, "ocid1.vcn.oc1..test", *response.Id)
+	assert.Exactly(t, 1, clientCertificates)
+
+	// The client certificate and its key must be set together
+	d.Set("client_private_key_path", "")
+	_, err = ProviderConfig(d)
+	assert.Error(t, err)
+}
+
+func TestUnitValidateEndpoint(t *testing.T) {
+	for _, endpoint := range []string{"https://objectstorage.example.com", "http://localhost:8080"} {
+		if _, errs := validateEndpoint(endpoint, "objectstorage"); len(errs) != 0 {
+			t.Errorf("Unexpected error validating '%s': %v", endpoint, errs)
+		}
+	}
+	for _, endpoint := range []string{"objectstorage.example.com", "ftp://example.com", "https://"} {
+		if _, errs := validateEndpoint(endpoint, "objectstorage"); len(errs) == 0 {
+			t.Errorf("Expected an error validating '%s'", endpoint)
+		}
+	}
+}
+
 /* This function is used in the test asserts to verify that an element in a set contains certain properties
  * properties is a map of nameOfProperty -> expectedValueOfProperty
  * presentProperties is an array of property names that are expected to be set in the set element but we don't care about matching the value
@@ -1,59 +1,153 @@
 package provider
 
 import (
+	"context"
+	"math/rand"
+	"net/http"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	oci_common "github.com/oracle/oci-go-sdk/common"
+
+	"github.com/oracle/terraform-provider-oci/crud"
 )
 
 const (
 	shortRetryTime       = 2 * time.Minute
 	longRetryTime        = 10 * time.Minute
+	defaultBaseBackoff   = 1 * time.Second
 	identityService      = "identity"
 	objectstorageService = "object_storage"
 )
 
-var timeWaitedCache map[uint]time.Duration
+// retrySettings are the retry arguments of the provider block, or of the `retry` block of a resource. They are not
+// modified once the clients are configured, so they can be shared by concurrent requests.
+type retrySettings struct {
+	disableAutoRetries bool
+	// When set, retryable errors are retried for this long instead of shortRetryTime or longRetryTime
+	maxDuration time.Duration
+	// The wait before the n-th retry is n^2 * baseBackoff, up to maxBackoff when it is set
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+	// Each wait is randomly shortened or lengthened by up to this percentage
+	jitterPercent        int
+	retryableStatusCodes map[int]bool
+	retryableErrorCodes  map[string]bool
+	// Set for the clients of the acceptance tests that replay recorded responses, see crud.FastForwarder
+	fastForward bool
+}
 
-//attempt starts at 1
-//quadratic backoff (attempt^2) with forced retries at shortRetryTime and longRetryTime
-func nextDuration(response oci_common.OCIOperationResponse) time.Duration {
-	return getNextDuration(response.AttemptNumber)
+// defaultRetrySettings apply to the requests made without the clients of a provider
+var defaultRetrySettings = &retrySettings{baseBackoff: defaultBaseBackoff}
+
+type retrySettingsContextKey struct{}
+
+// retrySettingsDispatcher attaches the retry settings of a provider or resource to its requests, so that the retry
+// policy, which only sees the responses, can find them. Concurrent requests of providers and resources with different
+// settings don't share any state.
+type retrySettingsDispatcher struct {
+	oci_common.HTTPRequestDispatcher
+	settings *retrySettings
+}
+
+func (d retrySettingsDispatcher) Do(request *http.Request) (*http.Response, error) {
+	return d.HTTPRequestDispatcher.Do(request.WithContext(context.WithValue(request.Context(), retrySettingsContextKey{}, d.settings)))
+}
+
+// FastForward returns whether the waits for the resources of the clients are fast-forwarded.
+func (d retrySettingsDispatcher) FastForward() bool {
+	return d.settings.FastForward()
+}
+
+// retrySettingsFor returns the settings of the provider or resource that made the request.
+func retrySettingsFor(response oci_common.OCIOperationResponse) *retrySettings {
+	if response.Response != nil && response.Response.HTTPResponse() != nil && response.Response.HTTPResponse().Request != nil {
+		if settings, ok := response.Response.HTTPResponse().Request.Context().Value(retrySettingsContextKey{}).(*retrySettings); ok {
+			return settings
+		}
+	}
+	return defaultRetrySettings
 }
 
-func getNextDuration(attempt uint) time.Duration {
-	timeWaited := getTimeWaited(attempt)
-	nextDuration := time.Duration(attempt*attempt) * time.Second
-	if timeWaited < shortRetryTime && nextDuration+timeWaited > shortRetryTime {
-		nextDuration = shortRetryTime - timeWaited
+func (s *retrySettings) FastForward() bool {
+	return s.fastForward
+}
+
+func (s *retrySettings) shortRetryTime() time.Duration {
+	if s.maxDuration > 0 {
+		return s.maxDuration
 	}
-	if timeWaited < longRetryTime && nextDuration+timeWaited > longRetryTime {
-		nextDuration = longRetryTime - timeWaited
+	return shortRetryTime
+}
+
+func (s *retrySettings) longRetryTime() time.Duration {
+	if s.maxDuration > 0 {
+		return s.maxDuration
 	}
-	return nextDuration
+	return longRetryTime
 }
 
-func getTimeWaited(attempt uint) time.Duration {
-	if timeWaitedCache == nil {
-		timeWaitedCache = map[uint]time.Duration{}
+//attempt starts at 1
+//quadratic backoff (attempt^2) with forced retries at shortRetryTime and longRetryTime
+func (s *retrySettings) nextDuration(attempt uint, timeWaited time.Duration) time.Duration {
+	nextDuration := time.Duration(attempt*attempt) * s.baseBackoff
+	if s.maxBackoff > 0 && nextDuration > s.maxBackoff {
+		nextDuration = s.maxBackoff
 	}
-	if timeWaited, ok := timeWaitedCache[attempt]; ok {
-		return timeWaited
+	if timeWaited < s.shortRetryTime() && nextDuration+timeWaited > s.shortRetryTime() {
+		nextDuration = s.shortRetryTime() - timeWaited
 	}
-	if attempt <= 1 {
-		return 0
+	if timeWaited < s.longRetryTime() && nextDuration+timeWaited > s.longRetryTime() {
+		nextDuration = s.longRetryTime() - timeWaited
+	}
+	return nextDuration
+}
+
+// timeWaited is the time waited before the given attempt. It ignores the jitter, so that it only depends on the attempt.
+func (s *retrySettings) timeWaited(attempt uint) time.Duration {
+	timeWaited := time.Duration(0)
+	for i := uint(1); i < attempt; i++ {
+		timeWaited += s.nextDuration(i, timeWaited)
 	}
-	timeWaited := getTimeWaited(attempt-1) + getNextDuration(attempt-1)
-	timeWaitedCache[attempt] = timeWaited
 	return timeWaited
 }
 
+func (s *retrySettings) jitter(duration time.Duration) time.Duration {
+	if s.jitterPercent <= 0 {
+		return duration
+	}
+	return duration + time.Duration(float64(duration)*float64(s.jitterPercent)/100*(2*rand.Float64()-1))
+}
+
+func (s *retrySettings) isRetryable(statusCode int, e error) bool {
+	if s.retryableStatusCodes[statusCode] {
+		return true
+	}
+	if serviceError, ok := oci_common.IsServiceError(e); ok && s.retryableErrorCodes[serviceError.GetCode()] {
+		return true
+	}
+	return false
+}
+
+func nextDuration(response oci_common.OCIOperationResponse) time.Duration {
+	settings := retrySettingsFor(response)
+	// The retries are still limited by the time they would have waited, when the waits are fast-forwarded
+	return crud.WaitDuration(settings, settings.jitter(settings.nextDuration(response.AttemptNumber, settings.timeWaited(response.AttemptNumber))))
+}
+
+func getTimeWaited(response oci_common.OCIOperationResponse) time.Duration {
+	return retrySettingsFor(response).timeWaited(response.AttemptNumber)
+}
+
 func shouldRetry(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string) bool {
-	if disableAutoRetries {
+	if response.Response == nil || response.Response.HTTPResponse() == nil {
 		return false
 	}
-	if response.Response == nil || response.Response.HTTPResponse() == nil {
+
+	settings := retrySettingsFor(response)
+	if settings.disableAutoRetries {
 		return false
 	}
 
@@ -64,9 +158,13 @@ func shouldRetry(response oci_common.OCIOperationResponse, disableNotFoundRetrie
 		return false
 	}
 
-	timeWaited := getTimeWaited(response.AttemptNumber)
-	shortTimeDecision := timeWaited < shortRetryTime
-	longTimeDecision := timeWaited < longRetryTime
+	timeWaited := settings.timeWaited(response.AttemptNumber)
+	shortTimeDecision := timeWaited < settings.shortRetryTime()
+	longTimeDecision := timeWaited < settings.longRetryTime()
+
+	if settings.isRetryable(statusCode, e) {
+		return longTimeDecision
+	}
 
 	switch statusCode {
 	case 400, 401, 403:
@@ -106,10 +204,276 @@ func getRetryPolicy(disableNotFoundRetries bool, service string) *oci_common.Ret
 	retryPolicy := &oci_common.RetryPolicy{
 		MaximumNumberAttempts: 0,
 		ShouldRetryOperation: func(response oci_common.OCIOperationResponse) bool {
-			return shouldRetry(response, disableNotFoundRetries, service)
+			if shouldRetry(response, disableNotFoundRetries, service) {
+				traceRetry(response)
+				return true
+			}
+			return false
 		},
 		NextDuration: nextDuration,
 	}
 
 	return retryPolicy
 }
+
+// retrySettingsFromProvider reads the retry arguments of the provider block.
+func retrySettingsFromProvider(d *schema.ResourceData) *retrySettings {
+	settings := &retrySettings{
+		disableAutoRetries:   d.Get("disable_auto_retries").(bool),
+		maxDuration:          time.Duration(d.Get("retry_duration_seconds").(int)) * time.Second,
+		baseBackoff:          time.Duration(d.Get("retry_base_backoff_seconds").(int)) * time.Second,
+		maxBackoff:           time.Duration(d.Get("retry_max_backoff_seconds").(int)) * time.Second,
+		jitterPercent:        d.Get("retry_jitter_percent").(int),
+		retryableStatusCodes: map[int]bool{},
+		retryableErrorCodes:  map[string]bool{},
+	}
+
+	for _, statusCode := range d.Get("retryable_status_codes").([]interface{}) {
+		settings.retryableStatusCodes[statusCode.(int)] = true
+	}
+
+	for _, errorCode := range d.Get("retryable_error_codes").([]interface{}) {
+		settings.retryableErrorCodes[errorCode.(string)] = true
+	}
+
+	return settings
+}
+
+// withOverrides returns the settings of a `retry` block of a resource, the arguments it leaves unset are inherited.
+func (s *retrySettings) withOverrides(raw map[string]interface{}) *retrySettings {
+	result := &retrySettings{
+		disableAutoRetries:   s.disableAutoRetries,
+		maxDuration:          s.maxDuration,
+		baseBackoff:          s.baseBackoff,
+		maxBackoff:           s.maxBackoff,
+		jitterPercent:        s.jitterPercent,
+		retryableStatusCodes: map[int]bool{},
+		retryableErrorCodes:  map[string]bool{},
+		fastForward:          s.fastForward,
+	}
+
+	if duration, ok := raw["duration_seconds"]; ok && duration.(int) != 0 {
+		result.maxDuration = time.Duration(duration.(int)) * time.Second
+	}
+
+	if baseBackoff, ok := raw["base_backoff_seconds"]; ok && baseBackoff.(int) != 0 {
+		result.baseBackoff = time.Duration(baseBackoff.(int)) * time.Second
+	}
+
+	if maxBackoff, ok := raw["max_backoff_seconds"]; ok && maxBackoff.(int) != 0 {
+		result.maxBackoff = time.Duration(maxBackoff.(int)) * time.Second
+	}
+
+	if jitterPercent, ok := raw["jitter_percent"]; ok && jitterPercent.(int) != 0 {
+		result.jitterPercent = jitterPercent.(int)
+	}
+
+	for statusCode := range s.retryableStatusCodes {
+		result.retryableStatusCodes[statusCode] = true
+	}
+	if statusCodes, ok := raw["retryable_status_codes"]; ok {
+		for _, statusCode := range statusCodes.([]interface{}) {
+			result.retryableStatusCodes[statusCode.(int)] = true
+		}
+	}
+
+	for errorCode := range s.retryableErrorCodes {
+		result.retryableErrorCodes[errorCode] = true
+	}
+	if errorCodes, ok := raw["retryable_error_codes"]; ok {
+		for _, errorCode := range errorCodes.([]interface{}) {
+			result.retryableErrorCodes[errorCode.(string)] = true
+		}
+	}
+
+	return result
+}
+
+func retrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				// Optional
+				"base_backoff_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 3600),
+				},
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 24*3600),
+				},
+				"jitter_percent": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 100),
+				},
+				"max_backoff_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 3600),
+				},
+				"retryable_error_codes": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"retryable_status_codes": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeInt,
+						ValidateFunc: validation.IntBetween(100, 599),
+					},
+				},
+			},
+		},
+	}
+}
+
+// addRetryBlock adds the `retry` block to a resource. Its settings apply to all the requests made by the resource,
+// through a copy of the clients that attaches them to each request.
+func addRetryBlock(resource *schema.Resource) {
+	if _, ok := resource.Schema["retry"]; ok {
+		return
+	}
+	resource.Schema["retry"] = retrySchema()
+
+	create, read, update, del := resource.Create, resource.Read, resource.Update, resource.Delete
+	resource.Create = func(d *schema.ResourceData, m interface{}) error {
+		return create(d, retryClientsFor(d, m))
+	}
+	resource.Read = func(d *schema.ResourceData, m interface{}) error {
+		return read(d, retryClientsFor(d, m))
+	}
+	resource.Delete = func(d *schema.ResourceData, m interface{}) error {
+		return del(d, retryClientsFor(d, m))
+	}
+
+	// Changing only the `retry` block doesn't change the resource, so it is refreshed instead
+	schemas := resource.Schema
+	resource.Update = func(d *schema.ResourceData, m interface{}) error {
+		m = retryClientsFor(d, m)
+		if update == nil || !hasChangesOtherThanRetry(d, schemas) {
+			return read(d, m)
+		}
+		return update(d, m)
+	}
+}
+
+func hasChangesOtherThanRetry(d *schema.ResourceData, schemas map[string]*schema.Schema) bool {
+	for key := range schemas {
+		if key != "retry" && d.HasChange(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// retryClientsFor returns the clients to use for a resource, which apply the settings of its `retry` block if it has one.
+func retryClientsFor(d *schema.ResourceData, m interface{}) interface{} {
+	clients, ok := m.(*OracleClients)
+	if !ok {
+		return m
+	}
+
+	retry, ok := d.GetOkExists("retry")
+	if !ok {
+		return m
+	}
+	tmpList := retry.([]interface{})
+	if len(tmpList) == 0 || tmpList[0] == nil {
+		return m
+	}
+
+	settings := clients.retrySettings
+	if settings == nil {
+		settings = defaultRetrySettings
+	}
+
+	return clients.withRetrySettings(settings.withOverrides(tmpList[0].(map[string]interface{})))
+}
+
+// withRetrySettings returns a copy of the clients that attach the given settings to their requests.
+func (clients *OracleClients) withRetrySettings(settings *retrySettings) *OracleClients {
+	result := *clients
+	result.retrySettings = settings
+
+	withDispatcher := func(client *oci_common.BaseClient) {
+		dispatcher := client.HTTPClient
+		if retryDispatcher, ok := dispatcher.(retrySettingsDispatcher); ok {
+			dispatcher = retryDispatcher.HTTPRequestDispatcher
+		}
+		client.HTTPClient = retrySettingsDispatcher{HTTPRequestDispatcher: dispatcher, settings: settings}
+	}
+
+	if clients.auditClient != nil {
+		tmp := *clients.auditClient
+		withDispatcher(&tmp.BaseClient)
+		result.auditClient = &tmp
+	}
+	if clients.blockstorageClient != nil {
+		tmp := *clients.blockstorageClient
+		withDispatcher(&tmp.BaseClient)
+		result.blockstorageClient = &tmp
+	}
+	if clients.computeClient != nil {
+		tmp := *clients.computeClient
+		withDispatcher(&tmp.BaseClient)
+		result.computeClient = &tmp
+	}
+	if clients.databaseClient != nil {
+		tmp := *clients.databaseClient
+		withDispatcher(&tmp.BaseClient)
+		result.databaseClient = &tmp
+	}
+	if clients.dnsClient != nil {
+		tmp := *clients.dnsClient
+		withDispatcher(&tmp.BaseClient)
+		result.dnsClient = &tmp
+	}
+	if clients.identityClient != nil {
+		tmp := *clients.identityClient
+		withDispatcher(&tmp.BaseClient)
+		result.identityClient = &tmp
+	}
+	if clients.virtualNetworkClient != nil {
+		tmp := *clients.virtualNetworkClient
+		withDispatcher(&tmp.BaseClient)
+		result.virtualNetworkClient = &tmp
+	}
+	if clients.objectStorageClient != nil {
+		tmp := *clients.objectStorageClient
+		withDispatcher(&tmp.BaseClient)
+		result.objectStorageClient = &tmp
+	}
+	if clients.loadBalancerClient != nil {
+		tmp := *clients.loadBalancerClient
+		withDispatcher(&tmp.BaseClient)
+		result.loadBalancerClient = &tmp
+	}
+	if clients.fileStorageClient != nil {
+		tmp := *clients.fileStorageClient
+		withDispatcher(&tmp.BaseClient)
+		result.fileStorageClient = &tmp
+	}
+	if clients.emailClient != nil {
+		tmp := *clients.emailClient
+		withDispatcher(&tmp.BaseClient)
+		result.emailClient = &tmp
+	}
+	if clients.containerEngineClient != nil {
+		tmp := *clients.containerEngineClient
+		withDispatcher(&tmp.BaseClient)
+		result.containerEngineClient = &tmp
+	}
+
+	return &result
+}
@@ -0,0 +1,311 @@
+// Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/common"
+	oci_core "github.com/oracle/oci-go-sdk/core"
+)
+
+type testRequestSigner struct{}
+
+func (s testRequestSigner) Sign(r *http.Request) error {
+	return nil
+}
+
+// newTestVirtualNetworkClient returns a client of a local service that fails every request with the given status and
+// error code, and counts the requests.
+func newTestVirtualNetworkClient(t *testing.T, statusCode int, errorCode string, requests *int32) (*oci_core.VirtualNetworkClient, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		fmt.Fprintf(w, `{"code": "%s", "message": "test"}`, errorCode)
+	}))
+
+	client := oci_core.VirtualNetworkClient{BaseClient: oci_common.DefaultBaseClientWithSigner(testRequestSigner{})}
+	client.Host = server.URL
+	client.UserAgent = "test"
+
+	return &client, server.Close
+}
+
+func TestUnitRetrySettingsDefaultSchedule(t *testing.T) {
+	settings := &retrySettings{baseBackoff: defaultBaseBackoff}
+
+	if duration := settings.nextDuration(1, 0); duration != time.Second {
+		t.Errorf("Expected the first retry after 1s, got %v", duration)
+	}
+	if duration := settings.nextDuration(3, 0); duration != 9*time.Second {
+		t.Errorf("Expected the third retry after 9s, got %v", duration)
+	}
+
+	// The retries are forced at shortRetryTime and longRetryTime
+	reachedShortRetryTime, reachedLongRetryTime := false, false
+	for attempt := uint(1); attempt < 20; attempt++ {
+		timeWaited := settings.timeWaited(attempt)
+		reachedShortRetryTime = reachedShortRetryTime || timeWaited == shortRetryTime
+		reachedLongRetryTime = reachedLongRetryTime || timeWaited == longRetryTime
+	}
+	if !reachedShortRetryTime || !reachedLongRetryTime {
+		t.Errorf("Expected retries at exactly %v and %v", shortRetryTime, longRetryTime)
+	}
+}
+
+func TestUnitRetrySettingsMaxBackoffAndDuration(t *testing.T) {
+	settings := &retrySettings{baseBackoff: time.Second, maxBackoff: 5 * time.Second, maxDuration: 30 * time.Second}
+
+	if duration := settings.nextDuration(10, 0); duration != 5*time.Second {
+		t.Errorf("Expected the backoff to be limited to 5s, got %v", duration)
+	}
+	if settings.shortRetryTime() != 30*time.Second || settings.longRetryTime() != 30*time.Second {
+		t.Errorf("Expected the retry duration to replace both default durations")
+	}
+
+	var attempt uint
+	for attempt = 1; settings.timeWaited(attempt) < 30*time.Second; attempt++ {
+	}
+	if settings.timeWaited(attempt) != 30*time.Second {
+		t.Errorf("Expected the last retry at exactly 30s, got %v", settings.timeWaited(attempt))
+	}
+}
+
+func TestUnitRetrySettingsJitter(t *testing.T) {
+	settings := &retrySettings{baseBackoff: time.Second, jitterPercent: 50}
+
+	varied := false
+	for i := 0; i < 100; i++ {
+		duration := settings.jitter(10 * time.Second)
+		if duration < 5*time.Second || duration > 15*time.Second {
+			t.Fatalf("Expected a jittered duration between 5s and 15s, got %v", duration)
+		}
+		varied = varied || duration != 10*time.Second
+	}
+	if !varied {
+		t.Errorf("Expected the jitter to vary the duration")
+	}
+
+	if duration := (&retrySettings{}).jitter(10 * time.Second); duration != 10*time.Second {
+		t.Errorf("Expected no jitter by default, got %v", duration)
+	}
+}
+
+func TestUnitRetrySettingsWithOverrides(t *testing.T) {
+	settings := &retrySettings{
+		maxDuration:          time.Minute,
+		baseBackoff:          time.Second,
+		jitterPercent:        10,
+		retryableStatusCodes: map[int]bool{409: true},
+		retryableErrorCodes:  map[string]bool{},
+	}
+
+	result := settings.withOverrides(map[string]interface{}{
+		"duration_seconds":       0,
+		"base_backoff_seconds":   2,
+		"max_backoff_seconds":    0,
+		"jitter_percent":         0,
+		"retryable_status_codes": []interface{}{404},
+		"retryable_error_codes":  []interface{}{"TooManyRequests"},
+	})
+
+	if result.maxDuration != time.Minute || result.jitterPercent != 10 {
+		t.Errorf("Expected the unset arguments to be inherited, got %+v", result)
+	}
+	if result.baseBackoff != 2*time.Second {
+		t.Errorf("Expected a base backoff of 2s, got %v", result.baseBackoff)
+	}
+	if !result.retryableStatusCodes[404] || !result.retryableStatusCodes[409] || !result.retryableErrorCodes["TooManyRequests"] {
+		t.Errorf("Expected the retryable codes to be added to the ones of the provider, got %+v", result)
+	}
+	if settings.retryableStatusCodes[404] {
+		t.Errorf("Expected the settings of the provider to be left unchanged")
+	}
+}
+
+func TestUnitShouldRetryResourceSettings(t *testing.T) {
+	var requests int32
+	client, closeServer := newTestVirtualNetworkClient(t, 400, "LimitExceeded", &requests)
+	defer closeServer()
+
+	request := oci_core.GetVcnRequest{VcnId: oci_common.String("ocid1.vcn.oc1..test")}
+	request.RequestMetadata.RetryPolicy = getRetryPolicy(false, "core")
+
+	// 400 is not retried by default
+	if _, err := client.GetVcn(context.Background(), request); err == nil {
+		t.Fatalf("Expected the request to fail")
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request without retries, got %d", requests)
+	}
+
+	// The settings of a resource are attached to its requests, and make the error code retryable
+	settings := &retrySettings{
+		maxDuration:          100 * time.Millisecond,
+		baseBackoff:          10 * time.Millisecond,
+		retryableStatusCodes: map[int]bool{},
+		retryableErrorCodes:  map[string]bool{"LimitExceeded": true},
+	}
+	clients := (&OracleClients{virtualNetworkClient: client}).withRetrySettings(settings)
+
+	atomic.StoreInt32(&requests, 0)
+	if _, err := clients.virtualNetworkClient.GetVcn(context.Background(), request); err == nil {
+		t.Fatalf("Expected the request to fail")
+	}
+	if requests < 2 {
+		t.Errorf("Expected the request to be retried, got %d requests", requests)
+	}
+
+	// The clients of the provider are left unchanged
+	if _, ok := client.HTTPClient.(retrySettingsDispatcher); ok {
+		t.Errorf("Expected the clients of the provider to be left unchanged")
+	}
+}
+
+func TestUnitRetryBlock(t *testing.T) {
+	var reads, updates int
+	resource := &schema.Resource{
+		Create: func(d *schema.ResourceData, m interface{}) error { return nil },
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			reads++
+			if _, ok := m.(*OracleClients).virtualNetworkClient.HTTPClient.(retrySettingsDispatcher); !ok {
+				t.Errorf("Expected the clients to apply the settings of the retry block")
+			}
+			return nil
+		},
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			updates++
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error { return nil },
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+	addRetryBlock(resource)
+
+	client := oci_core.VirtualNetworkClient{BaseClient: oci_common.DefaultBaseClientWithSigner(testRequestSigner{})}
+	clients := &OracleClients{virtualNetworkClient: &client}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"retry": []interface{}{
+			map[string]interface{}{
+				"retryable_status_codes": []interface{}{404},
+			},
+		},
+	})
+
+	// Only the retry block changed, so the resource is refreshed instead of updated
+	if err := resource.Update(d, clients); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if reads != 1 || updates != 0 {
+		t.Errorf("Expected 1 read and no update, got %d reads and %d updates", reads, updates)
+	}
+}
+
+// newTestVcnService returns a local service that throttles the first request for each VCN, and counts the requests.
+func newTestVcnService() (*httptest.Server, func(vcnId string) int) {
+	var lock sync.Mutex
+	requests := map[string]int{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vcnId := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+
+		lock.Lock()
+		requests[vcnId]++
+		count := requests[vcnId]
+		lock.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if count == 1 {
+			w.WriteHeader(429)
+			fmt.Fprint(w, `{"code": "TooManyRequests", "message": "test"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"id": "%s", "cidrBlock": "10.0.0.0/16", "compartmentId": "ocid1.compartment.oc1..test", "displayName": "%s", "lifecycleState": "AVAILABLE"}`, vcnId, vcnId)
+	}))
+
+	return server, func(vcnId string) int {
+		lock.Lock()
+		defer lock.Unlock()
+		return requests[vcnId]
+	}
+}
+
+// Run with -race: resources of aliased providers with different retry settings are read concurrently.
+func TestUnitRetryConcurrentResources(t *testing.T) {
+	server, requestCount := newTestVcnService()
+	defer server.Close()
+
+	client := oci_core.VirtualNetworkClient{BaseClient: oci_common.DefaultBaseClientWithSigner(testRequestSigner{})}
+	client.Host = server.URL
+	client.UserAgent = "test"
+
+	retryingClients := (&OracleClients{virtualNetworkClient: &client}).withRetrySettings(&retrySettings{baseBackoff: time.Millisecond})
+	nonRetryingClients := (&OracleClients{virtualNetworkClient: &client}).withRetrySettings(&retrySettings{baseBackoff: time.Millisecond, disableAutoRetries: true})
+
+	vcnResource := resourcesMapWithRetryBlock()["oci_core_vcn"]
+
+	const resourceCount = 20
+	type testResource struct {
+		d       *schema.ResourceData
+		clients *OracleClients
+		retried bool
+		err     error
+	}
+	resources := []*testResource{}
+	for i := 0; i < resourceCount; i++ {
+		for _, retried := range []bool{true, false} {
+			resource := &testResource{d: schema.TestResourceDataRaw(t, vcnResource.Schema, map[string]interface{}{}), clients: nonRetryingClients, retried: retried}
+			if retried {
+				resource.clients = retryingClients
+			}
+			resource.d.SetId(fmt.Sprintf("ocid1.vcn.oc1..%d-%t", i, retried))
+			resources = append(resources, resource)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, resource := range resources {
+		wg.Add(1)
+		go func(resource *testResource) {
+			defer wg.Done()
+			resource.err = vcnResource.Read(resource.d, resource.clients)
+		}(resource)
+	}
+	wg.Wait()
+
+	for _, resource := range resources {
+		if resource.retried {
+			if resource.err != nil {
+				t.Errorf("Unexpected error reading %s: %v", resource.d.Id(), resource.err)
+			} else if resource.d.Get("display_name").(string) != resource.d.Id() {
+				t.Errorf("Expected the display name of %s to be read, got '%s'", resource.d.Id(), resource.d.Get("display_name").(string))
+			}
+			if count := requestCount(resource.d.Id()); count != 2 {
+				t.Errorf("Expected 2 requests for %s, got %d", resource.d.Id(), count)
+			}
+		} else {
+			if resource.err == nil {
+				t.Errorf("Expected an error reading %s without retries", resource.d.Id())
+			}
+			if count := requestCount(resource.d.Id()); count != 1 {
+				t.Errorf("Expected 1 request for %s, got %d", resource.d.Id(), count)
+			}
+		}
+	}
+}
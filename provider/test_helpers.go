@@ -5,6 +5,8 @@ package provider
 import (
 	"bytes"
 	"fmt"
+	"os"
+	"sync"
 	"text/template"
 	"time"
 
@@ -22,11 +24,29 @@ func apply(template string, values map[string]string) string {
 	return b.String()
 }
 
+// replayTimestamp replaces the timestamps of the tests in their fixtures, and when they are replayed
+const replayTimestamp = "2000-01-01-000000-0"
+
+// The timestamps returned so far, which are replaced in the fixtures of the tests that record their requests
+var timestamps = struct {
+	sync.Mutex
+	values []string
+}{}
+
 // Returns date-time formatted as a string, ex: 2017-10-12-000934-119299083"
 func timestamp() string {
+	if os.Getenv(httpModeEnvironmentVariable) == httpModeReplay {
+		return replayTimestamp
+	}
+
 	t := time.Now()
-	return fmt.Sprintf("%d-%02d-%02d-%02d%02d%02d-%d",
+	ts := fmt.Sprintf("%d-%02d-%02d-%02d%02d%02d-%d",
 		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
+
+	timestamps.Lock()
+	defer timestamps.Unlock()
+	timestamps.values = append(timestamps.values, ts)
+	return ts
 }
 
 type TokenFn func(string, map[string]string) string
@@ -1,10 +1,15 @@
 // Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
 
-package oci_tool
+package main
 
 import (
 	"fmt"
 	"os"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/oracle/terraform-provider-oci/provider"
 )
 
 // Copy target directory and append .backup
@@ -119,3 +124,31 @@ func AddRegionField(targetDir string, backupDir string) (err error) {
 	fmt.Println("Complete")
 	return
 }
+
+// Export the resources of a compartment to .tf files and import commands, and optionally a state file
+func Discover(compartmentId string, outputDir string, recursive bool, generateState bool) (err error) {
+	fmt.Println("Discovering compartment...", compartmentId, "-->", outputDir)
+
+	// The provider is configured like in a plan without arguments, from the OCI_ environment variables and the
+	// OCI CLI config file
+	ociProvider := provider.Provider(provider.ProviderConfig).(*schema.Provider)
+	err = ociProvider.Configure(terraform.NewResourceConfig(nil))
+
+	if err != nil {
+		return fmt.Errorf("Error configuring the provider\n %s", err)
+	}
+
+	err = provider.ExportCompartment(ociProvider.Meta(), provider.ExportOptions{
+		CompartmentId: compartmentId,
+		OutputDir:     outputDir,
+		Recursive:     recursive,
+		GenerateState: generateState,
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error exporting compartment\n %s", err)
+	}
+
+	fmt.Println("Complete")
+	return
+}
@@ -1,6 +1,6 @@
 // Copyright (c) 2017, Oracle and/or its affiliates. All rights reserved.
 
-package oci_tool
+package main
 
 import (
 	"flag"
@@ -105,6 +105,37 @@ func main() {
 		os.Exit(0)
 	}
 
+	if os.Args[1] == "discover" {
+		discover := flag.NewFlagSet("discover", flag.PanicOnError)
+		discover.Usage = func() {
+			discover.PrintDefaults()
+			os.Exit(0)
+		}
+		compartment := discover.String("compartment", "", "Required, specify the OCID of the compartment to export")
+		dir := discover.String("dir", "", "Required, specify the directory to write the configuration, import commands and state to")
+		recursive := discover.Bool("recursive", false, "Optional, whether to also export the sub-compartments")
+		state := discover.Bool("state", false, "Optional, whether to also write a state file of the exported resources")
+		err := discover.Parse(os.Args[2:])
+
+		if *compartment == "" || *dir == "" {
+			fmt.Println("Missing required compartment or directory flag\nCommand flags:")
+			discover.PrintDefaults()
+			os.Exit(1)
+		}
+
+		if err != nil {
+			panic(err)
+		}
+
+		err = Discover(*compartment, path.Clean(*dir), *recursive, *state)
+
+		if err != nil {
+			panic(err)
+		}
+
+		os.Exit(0)
+	}
+
 	fmt.Println("Unknown command")
 	os.Exit(1)
 }